@@ -0,0 +1,6 @@
+action "coolify_application_execute_command" "migrate" {
+  config {
+    uuid    = "mc8gw00wscww4gskgk0gwgw0"
+    command = "php artisan migrate --force"
+  }
+}
@@ -0,0 +1,18 @@
+action "coolify_application_lifecycle" "restart" {
+  config {
+    uuid      = "mc8gw00wscww4gskgk0gwgw0"
+    operation = "restart"
+  }
+}
+
+# Restart the application whenever its environment variables change
+resource "terraform_data" "envs" {
+  input = coolify_application_envs.example.env
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.coolify_application_lifecycle.restart]
+    }
+  }
+}
@@ -0,0 +1,7 @@
+action "coolify_database_lifecycle" "stop" {
+  config {
+    uuid      = coolify_postgresql_database.example.uuid
+    operation = "stop"
+    timeout   = 120
+  }
+}
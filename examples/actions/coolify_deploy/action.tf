@@ -0,0 +1,7 @@
+# Deploy every resource tagged with `production`, rebuilding without cache
+action "coolify_deploy" "production" {
+  config {
+    tags  = ["production"]
+    force = true
+  }
+}
@@ -0,0 +1,5 @@
+action "coolify_server_validate" "example" {
+  config {
+    uuid = coolify_server.example.uuid
+  }
+}
@@ -0,0 +1,7 @@
+action "coolify_service_lifecycle" "start" {
+  config {
+    uuid      = "sk0gw00wscww4gskgk0gwgw0"
+    operation = "start"
+    wait      = false
+  }
+}
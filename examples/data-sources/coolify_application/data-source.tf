@@ -2,3 +2,15 @@
 data "coolify_application" "example" {
   uuid = "abc123"
 }
+
+# Or look it up by domain
+data "coolify_application" "by_fqdn" {
+  fqdn = "https://app.example.com"
+}
+
+# Or by name within a project environment
+data "coolify_application" "by_name" {
+  project_uuid     = "def456"
+  environment_name = "production"
+  name             = "my-app"
+}
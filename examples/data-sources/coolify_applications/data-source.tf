@@ -9,6 +9,20 @@ data "coolify_applications" "filtered" {
   }
 }
 
+# Retrieve applications whose name starts with "api-", except one
+data "coolify_applications" "api" {
+  filter {
+    name   = "name"
+    match  = "prefix"
+    values = ["api-"]
+  }
+  filter {
+    name   = "uuid"
+    match  = "not"
+    values = ["abc123"]
+  }
+}
+
 output "all" {
   value = data.coolify_applications.all.applications
 }
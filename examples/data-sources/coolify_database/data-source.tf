@@ -0,0 +1,17 @@
+data "coolify_database" "example" {
+  uuid = "abc123"
+}
+
+output "database_status" {
+  value = data.coolify_database.example.status
+}
+
+# Engine specific settings are only set for the matching `database_type`
+output "postgres_user" {
+  value = data.coolify_database.example.postgresql.postgres_user
+}
+
+output "internal_db_url" {
+  value     = data.coolify_database.example.internal_db_url
+  sensitive = true
+}
@@ -0,0 +1,28 @@
+# Retrieve all databases
+data "coolify_databases" "all" {}
+
+# Retrieve running PostgreSQL databases which are not public
+data "coolify_databases" "postgres" {
+  filter {
+    name   = "database_type"
+    values = ["standalone-postgresql"]
+  }
+  # (AND)
+  filter {
+    name   = "status"
+    match  = "prefix"
+    values = ["running"]
+  }
+  filter {
+    name   = "is_public"
+    values = ["false"]
+  }
+}
+
+output "all" {
+  value = data.coolify_databases.all.databases[*].name
+}
+
+output "postgres_uuids" {
+  value = data.coolify_databases.postgres.databases[*].uuid
+}
@@ -0,0 +1,25 @@
+data "coolify_inventory" "this" {
+  # Maximum number of concurrent API calls
+  concurrency = 8
+}
+
+# Look up a server by name, without joining on numeric IDs
+output "localhost_uuid" {
+  value = data.coolify_inventory.this.servers_by_name["localhost"].uuid
+}
+
+# Look up a resource by `<project>/<environment>/<name>`
+output "website_status" {
+  value = data.coolify_inventory.this.resources_by_name["website/production/web"].status
+}
+
+# Walk the graph, ie count the resources per server
+output "resources_per_server" {
+  value = {
+    for server in data.coolify_inventory.this.servers : server.name => length(flatten([
+      for project in server.projects : [
+        for env in project.environments : env.resources
+      ]
+    ]))
+  }
+}
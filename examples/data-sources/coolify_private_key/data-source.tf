@@ -3,6 +3,11 @@ data "coolify_private_key" "example" {
   uuid = "abc123"
 }
 
+# Or look it up by name, or by fingerprint
+data "coolify_private_key" "by_name" {
+  name = "localhost's key"
+}
+
 # Example outputs
 output "private_key_name" {
   value = data.coolify_private_key.example.name
@@ -0,0 +1,29 @@
+# Retrieve every application, service and database of the team
+data "coolify_resources" "all" {}
+
+# Retrieve the resources in production which have exited
+data "coolify_resources" "exited_in_production" {
+  filter {
+    name   = "environment_name"
+    values = ["production"]
+  }
+  # (AND)
+  filter {
+    name   = "status"
+    match  = "prefix"
+    values = ["exited"]
+  }
+}
+
+check "production_is_running" {
+  assert {
+    condition     = length(data.coolify_resources.exited_in_production.resources) == 0
+    error_message = "Exited resources in production: ${join(", ", data.coolify_resources.exited_in_production.resources[*].name)}"
+  }
+}
+
+output "resources_by_server" {
+  value = {
+    for res in data.coolify_resources.all.resources : res.server_name => res.name...
+  }
+}
@@ -3,6 +3,11 @@ data "coolify_server" "example" {
   uuid = "abc123"
 }
 
+# Or look it up by IP address, or by name
+data "coolify_server" "by_ip" {
+  ip = "203.0.113.10"
+}
+
 output "server_address" {
   # user@ip:port
   value = "${data.coolify_server.example.user}@${data.coolify_server.example.ip}:${data.coolify_server.example.port}"
@@ -0,0 +1,22 @@
+# Read the latest metrics collected by the Sentinel agent of a server
+data "coolify_server_metrics" "example" {
+  server_uuid    = "abc123"
+  sentinel_url   = "http://10.0.0.2:8888"
+  window_minutes = 10
+}
+
+# Refuse to place a new workload on a busy server, treating metrics the agent did not report as busy
+resource "coolify_postgresql_database" "example" {
+  # ...
+
+  lifecycle {
+    precondition {
+      condition = alltrue([
+        coalesce(data.coolify_server_metrics.example.cpu_usage_percent, 100) < 80,
+        coalesce(data.coolify_server_metrics.example.memory_usage_percent, 100) < 80,
+        coalesce(data.coolify_server_metrics.example.disk_usage_percent, 100) < 90,
+      ])
+      error_message = "Server is above 80% CPU or memory usage, or above 90% disk usage."
+    }
+  }
+}
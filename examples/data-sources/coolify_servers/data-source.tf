@@ -14,6 +14,30 @@ data "coolify_servers" "filtered" {
   }
 }
 
+# Retrieve reachable servers, any attribute can be filtered on, including nested ones
+data "coolify_servers" "reachable" {
+  filter {
+    name   = "settings.is_reachable"
+    values = ["true"]
+  }
+}
+
+# Retrieve the newest server named "worker"
+data "coolify_servers" "newest_worker" {
+  filter {
+    name   = "name"
+    values = ["worker"]
+  }
+  most_recent = true
+}
+
+# Retrieve the first two servers by name
+data "coolify_servers" "sorted" {
+  sort_by = "name"
+  order   = "asc"
+  limit   = 2
+}
+
 output "all" {
   value = data.coolify_servers.all.servers
 }
@@ -21,3 +45,7 @@ output "all" {
 output "filtered" {
   value = data.coolify_servers.filtered.servers
 }
+
+output "newest_worker_uuid" {
+  value = one(data.coolify_servers.newest_worker.servers[*].uuid)
+}
@@ -0,0 +1,22 @@
+# Retrieve all services
+data "coolify_services" "all" {}
+
+# Retrieve every Plausible instance, including its applications and databases
+data "coolify_services" "plausible" {
+  with_resources = true
+
+  filter {
+    name   = "service_type"
+    values = ["plausible"]
+  }
+}
+
+output "all" {
+  value = data.coolify_services.all.services[*].name
+}
+
+output "plausible_domains" {
+  value = flatten([
+    for service in data.coolify_services.plausible.services : service.applications[*].fqdn
+  ])
+}
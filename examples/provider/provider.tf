@@ -13,6 +13,13 @@ provider "coolify" {
   # environment variable, e.g. by adding the following line to .bashrc:
   # export COOLIFY_TOKEN="Your API token"
   token = "Your API token"
+
+  # Optionally throttle requests, ie to stay below the rate limit of Coolify Cloud API tokens
+  # rate_limit = {
+  #   requests_per_second = 2
+  #   burst               = 5
+  # }
+  # max_concurrent_requests = 4
 }
 
 # Generate a new private key, and create a server with that key.
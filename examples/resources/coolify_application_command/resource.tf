@@ -0,0 +1,14 @@
+# Run the database migrations whenever a new version is deployed
+resource "coolify_application_command" "migrate" {
+  application_uuid = "mc8gw00wscww4gskgk0gwgw0"
+  command          = "php artisan migrate --force"
+  timeout          = 600
+
+  triggers = {
+    version = var.app_version
+  }
+}
+
+output "migrate_output" {
+  value = coolify_application_command.migrate.output
+}
@@ -0,0 +1 @@
+terraform import coolify_application_swarm.example <application_uuid>
\ No newline at end of file
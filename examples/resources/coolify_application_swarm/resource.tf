@@ -0,0 +1,12 @@
+# The application must run on a server that is already a swarm manager,
+# ie with `is_swarm_manager` enabled by its coolify_server_settings in an earlier apply.
+resource "coolify_application_swarm" "example" {
+  application_uuid = "abc123"
+  replicas         = 3
+
+  placement_constraints = <<-EOT
+    placement:
+      constraints:
+        - 'node.role == worker'
+  EOT
+}
@@ -12,3 +12,26 @@ output "public_key" {
   value     = tls_private_key.example.public_key_pem
   sensitive = false
 }
+
+# Generate the key in the provider, and authorize it on the target host
+resource "coolify_private_key" "generated" {
+  name        = "Example Generated Key"
+  description = "Managed by Terraform"
+  algorithm   = "ED25519"
+
+  # Changing a trigger creates a new key, switches every server using this key to it, then deletes the old key.
+  # The servers must accept the new key when they are validated, or the rotation rolls back.
+  rotation_triggers = {
+    rotated_at = "2026-01-01"
+  }
+}
+
+output "cloud_init" {
+  value = <<-EOT
+    #cloud-config
+    users:
+      - name: root
+        ssh_authorized_keys:
+          - ${coolify_private_key.generated.public_key}
+  EOT
+}
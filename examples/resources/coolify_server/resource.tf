@@ -18,4 +18,9 @@ resource "coolify_server" "example" {
   user             = "root"
   private_key_uuid = coolify_private_key.example.uuid
   instant_validate = false
+  proxy_type       = "traefik"
+
+  # Validate the server and wait until it can host resources
+  wait_for_ready = true
+  ready_timeout  = 600
 }
@@ -0,0 +1 @@
+terraform import coolify_server_log_drain.example <server_uuid>
\ No newline at end of file
@@ -0,0 +1,13 @@
+variable "axiom_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "coolify_server_log_drain" "example" {
+  server_uuid = coolify_server.example.uuid
+
+  axiom {
+    api_key      = var.axiom_api_key
+    dataset_name = "coolify-logs"
+  }
+}
@@ -0,0 +1 @@
+terraform import coolify_server_settings.example <server_uuid>
\ No newline at end of file
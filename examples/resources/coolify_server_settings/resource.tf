@@ -0,0 +1,22 @@
+resource "coolify_server_settings" "example" {
+  server_uuid = coolify_server.example.uuid
+
+  concurrent_builds = 4
+  dynamic_timeout   = 3600
+
+  docker_cleanup_frequency = "0 3 * * *"
+  docker_cleanup_threshold = 80
+  delete_unused_volumes    = true
+  delete_unused_networks   = true
+  force_server_cleanup     = false
+
+  wildcard_domain = "https://apps.example.com"
+
+  is_sentinel_enabled                   = true
+  is_metrics_enabled                    = true
+  sentinel_metrics_history_days         = 7
+  sentinel_metrics_refresh_rate_seconds = 10
+
+  is_swarm_manager = false
+  is_swarm_worker  = false
+}
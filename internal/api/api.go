@@ -7,8 +7,6 @@ import (
 	"net/http"
 	"regexp"
 	"time"
-
-	"github.com/hashicorp/go-retryablehttp"
 )
 
 const UserAgentPrefix = "terraform-provider-coolify"
@@ -18,26 +16,31 @@ var (
 	ErrInvalidToken = errors.New("invalid token format")
 )
 
+// attemptTimeout is the maximum time of each attempt of a request, until its body is read.
+var attemptTimeout = 30 * time.Second
+
 type RetryConfig struct {
 	MaxAttempts int64
 	MinWait     int64
 	MaxWait     int64
 }
 
-func NewAPIClient(version, server, apiToken string, retry RetryConfig) (*ClientWithResponses, error) {
+func NewAPIClient(version, server, apiToken string, retry RetryConfig, limit LimitConfig) (*ClientWithResponses, error) {
 	if err := ValidateTokenFormat(apiToken); err != nil {
 		return nil, err
 	}
 
-	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = int(retry.MaxAttempts)
-	retryClient.RetryWaitMin = time.Duration(retry.MinWait) * time.Second
-	retryClient.RetryWaitMax = time.Duration(retry.MaxWait) * time.Second
-	retryClient.Backoff = retryablehttp.DefaultBackoff
-	retryClient.Logger = nil
-
-	httpClient := retryClient.StandardClient()
-	httpClient.Timeout = 30 * time.Second
+	// The timeout applies to each attempt once it is sent,
+	// so waiting for a retry, the rate limit or a free slot does not count towards it
+	attemptClient := &http.Client{
+		Transport: newLimitTransport(limit, &timeoutTransport{
+			base:    http.DefaultTransport.(*http.Transport).Clone(),
+			timeout: attemptTimeout,
+		}),
+	}
+	httpClient := &http.Client{
+		Transport: newRetryTransport(retry, attemptClient),
+	}
 
 	return NewClientWithResponses(server,
 		WithHTTPClient(httpClient),
@@ -1991,6 +1991,12 @@ type UpdateApplicationByUuidJSONBody struct {
 	// StartCommand The start command.
 	StartCommand *string `json:"start_command,omitempty"`
 
+	// SwarmPlacementConstraints Swarm placement constraints, base64 encoded. Only used for swarm deployments.
+	SwarmPlacementConstraints *string `json:"swarm_placement_constraints,omitempty"`
+
+	// SwarmReplicas Swarm replicas. Only used for swarm deployments.
+	SwarmReplicas *int `json:"swarm_replicas,omitempty"`
+
 	// UseBuildServer Use build server.
 	UseBuildServer *bool `json:"use_build_server"`
 
@@ -2838,9 +2844,30 @@ type CreateServerJSONBodyProxyType string
 
 // UpdateServerByUuidJSONBody defines parameters for UpdateServerByUuid.
 type UpdateServerByUuidJSONBody struct {
+	// ConcurrentBuilds The number of concurrent builds.
+	ConcurrentBuilds *int `json:"concurrent_builds,omitempty"`
+
+	// DeleteUnusedNetworks Delete unused networks during docker cleanup.
+	DeleteUnusedNetworks *bool `json:"delete_unused_networks,omitempty"`
+
+	// DeleteUnusedVolumes Delete unused volumes during docker cleanup.
+	DeleteUnusedVolumes *bool `json:"delete_unused_volumes,omitempty"`
+
 	// Description The description of the server.
 	Description *string `json:"description,omitempty"`
 
+	// DockerCleanupFrequency The docker cleanup frequency as a cron expression.
+	DockerCleanupFrequency *string `json:"docker_cleanup_frequency,omitempty"`
+
+	// DockerCleanupThreshold The disk usage percentage that triggers a docker cleanup.
+	DockerCleanupThreshold *int `json:"docker_cleanup_threshold,omitempty"`
+
+	// DynamicTimeout The deployment timeout in seconds.
+	DynamicTimeout *int `json:"dynamic_timeout,omitempty"`
+
+	// ForceServerCleanup Force docker cleanup regardless of the disk usage threshold.
+	ForceServerCleanup *bool `json:"force_server_cleanup,omitempty"`
+
 	// InstantValidate Instant validate.
 	InstantValidate *bool `json:"instant_validate,omitempty"`
 
@@ -2850,6 +2877,51 @@ type UpdateServerByUuidJSONBody struct {
 	// IsBuildServer Is build server.
 	IsBuildServer *bool `json:"is_build_server,omitempty"`
 
+	// IsLogdrainAxiomEnabled Enable the Axiom log drain.
+	IsLogdrainAxiomEnabled *bool `json:"is_logdrain_axiom_enabled,omitempty"`
+
+	// IsLogdrainCustomEnabled Enable the custom Fluent Bit log drain.
+	IsLogdrainCustomEnabled *bool `json:"is_logdrain_custom_enabled,omitempty"`
+
+	// IsLogdrainHighlightEnabled Enable the Highlight log drain.
+	IsLogdrainHighlightEnabled *bool `json:"is_logdrain_highlight_enabled,omitempty"`
+
+	// IsLogdrainNewrelicEnabled Enable the New Relic log drain.
+	IsLogdrainNewrelicEnabled *bool `json:"is_logdrain_newrelic_enabled,omitempty"`
+
+	// IsMetricsEnabled Enable metrics collection by the Sentinel agent.
+	IsMetricsEnabled *bool `json:"is_metrics_enabled,omitempty"`
+
+	// IsSentinelEnabled Enable the Sentinel agent.
+	IsSentinelEnabled *bool `json:"is_sentinel_enabled,omitempty"`
+
+	// IsSwarmManager Use the server as a Docker Swarm manager.
+	IsSwarmManager *bool `json:"is_swarm_manager,omitempty"`
+
+	// IsSwarmWorker Use the server as a Docker Swarm worker.
+	IsSwarmWorker *bool `json:"is_swarm_worker,omitempty"`
+
+	// LogdrainAxiomApiKey The Axiom API key.
+	LogdrainAxiomApiKey *string `json:"logdrain_axiom_api_key,omitempty"`
+
+	// LogdrainAxiomDatasetName The Axiom dataset name.
+	LogdrainAxiomDatasetName *string `json:"logdrain_axiom_dataset_name,omitempty"`
+
+	// LogdrainCustomConfig The custom Fluent Bit configuration.
+	LogdrainCustomConfig *string `json:"logdrain_custom_config,omitempty"`
+
+	// LogdrainCustomConfigParser The custom Fluent Bit parser configuration.
+	LogdrainCustomConfigParser *string `json:"logdrain_custom_config_parser,omitempty"`
+
+	// LogdrainHighlightProjectId The Highlight project ID.
+	LogdrainHighlightProjectId *string `json:"logdrain_highlight_project_id,omitempty"`
+
+	// LogdrainNewrelicBaseUri The New Relic base URI.
+	LogdrainNewrelicBaseUri *string `json:"logdrain_newrelic_base_uri,omitempty"`
+
+	// LogdrainNewrelicLicenseKey The New Relic license key.
+	LogdrainNewrelicLicenseKey *string `json:"logdrain_newrelic_license_key,omitempty"`
+
 	// Name The name of the server.
 	Name *string `json:"name,omitempty"`
 
@@ -2862,8 +2934,17 @@ type UpdateServerByUuidJSONBody struct {
 	// ProxyType The proxy type.
 	ProxyType *UpdateServerByUuidJSONBodyProxyType `json:"proxy_type,omitempty"`
 
+	// SentinelMetricsHistoryDays The number of days metrics are kept.
+	SentinelMetricsHistoryDays *int `json:"sentinel_metrics_history_days,omitempty"`
+
+	// SentinelMetricsRefreshRateSeconds The metrics collection interval in seconds.
+	SentinelMetricsRefreshRateSeconds *int `json:"sentinel_metrics_refresh_rate_seconds,omitempty"`
+
 	// User The user of the server.
 	User *string `json:"user,omitempty"`
+
+	// WildcardDomain The wildcard domain used to generate application domains.
+	WildcardDomain *string `json:"wildcard_domain,omitempty"`
 }
 
 // UpdateServerByUuidJSONBodyProxyType defines parameters for UpdateServerByUuid.
@@ -49,7 +49,7 @@ func TestAPIClient(t *testing.T) {
 	}
 
 	// Test with valid token
-	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, retryConfig)
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, retryConfig, api.LimitConfig{})
 	if err != nil {
 		t.Fatalf("Failed to create API client: %v", err)
 	}
@@ -65,7 +65,7 @@ func TestAPIClient(t *testing.T) {
 
 	// Test with invalid token
 	invalidToken := "invalid_token"
-	_, err = api.NewAPIClient("test", mockServer.URL, invalidToken, retryConfig)
+	_, err = api.NewAPIClient("test", mockServer.URL, invalidToken, retryConfig, api.LimitConfig{})
 	if err == nil {
 		t.Fatalf("Expected error when creating API client with invalid token, got none")
 	}
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// maxErrorBodyLength limits how much of a body that is not JSON ends up in a diagnostic, ie an HTML error page.
+const maxErrorBodyLength = 500
+
+// Error is an unsuccessful response from the Coolify API.
+type Error struct {
+	StatusCode int
+	Status     string
+
+	// Message is the `message` or `error` of the response envelope.
+	Message string
+
+	// Fields holds the validation errors of a 422 response, keyed by the name of the field.
+	Fields map[string][]string
+
+	// Body is the raw body, set when it is not a JSON envelope.
+	Body string
+}
+
+// errorEnvelope covers the Laravel validation response and the error responses of the Coolify API:
+//
+//	{"message": "Validation failed.", "errors": {"postgres_user": ["The postgres user field is required."]}}
+//	{"message": "Resource not found."}
+//	{"error": "Unauthenticated."}
+type errorEnvelope struct {
+	Message string                     `json:"message"`
+	Error   string                     `json:"error"`
+	Errors  map[string]json.RawMessage `json:"errors"`
+}
+
+// DecodeError decodes the body of an unsuccessful response.
+// The response may be nil when the status is unknown.
+func DecodeError(resp *http.Response, body []byte) *Error {
+	apiErr := &Error{}
+	if resp != nil {
+		apiErr.StatusCode = resp.StatusCode
+		apiErr.Status = resp.Status
+	}
+	switch {
+	case apiErr.StatusCode == 0:
+		apiErr.Status = "no response"
+	case apiErr.Status == "":
+		apiErr.Status = fmt.Sprintf("%d %s", apiErr.StatusCode, http.StatusText(apiErr.StatusCode))
+	}
+
+	var envelope errorEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		apiErr.Body = truncate(strings.TrimSpace(string(body)), maxErrorBodyLength)
+		return apiErr
+	}
+
+	apiErr.Message = envelope.Message
+	if apiErr.Message == "" {
+		apiErr.Message = envelope.Error
+	}
+
+	for field, raw := range envelope.Errors {
+		// Messages are usually a list, but some endpoints return a single string
+		var messages []string
+		if err := json.Unmarshal(raw, &messages); err != nil {
+			var message string
+			if err := json.Unmarshal(raw, &message); err != nil {
+				message = string(raw)
+			}
+			messages = []string{message}
+		}
+		if apiErr.Fields == nil {
+			apiErr.Fields = map[string][]string{}
+		}
+		apiErr.Fields[field] = messages
+	}
+
+	if apiErr.Message == "" && len(apiErr.Fields) == 0 {
+		apiErr.Body = truncate(strings.TrimSpace(string(body)), maxErrorBodyLength)
+	}
+
+	return apiErr
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("received %s: %s", e.Status, e.Detail())
+}
+
+// IsNotFound reports whether the requested resource does not exist.
+func (e *Error) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// Summary describes the kind of error for the given operation, ie `Invalid attributes creating postgresql database`.
+func (e *Error) Summary(operation string) string {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return "Unauthorized " + operation
+	case e.StatusCode == http.StatusForbidden:
+		return "Permission denied " + operation
+	case e.StatusCode == http.StatusNotFound:
+		return "Not found " + operation
+	case e.StatusCode == http.StatusConflict:
+		return "Conflict " + operation
+	case e.StatusCode == http.StatusUnprocessableEntity:
+		return "Invalid attributes " + operation
+	case e.StatusCode >= http.StatusInternalServerError:
+		return "Coolify server error " + operation
+	default:
+		return "Unexpected HTTP status code " + operation
+	}
+}
+
+// Detail explains the error, followed by the message and the validation errors returned by Coolify.
+func (e *Error) Detail() string {
+	var hint string
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		hint = "The API token was rejected. Check that `token` or the COOLIFY_TOKEN environment variable holds a valid token."
+	case e.StatusCode == http.StatusForbidden:
+		hint = "The API token does not have the permissions required for this operation. Tokens need root access or the matching permissions."
+	case e.StatusCode == http.StatusNotFound:
+		hint = "The resource does not exist. It may have been deleted outside of Terraform."
+	case e.StatusCode == http.StatusConflict:
+		hint = "The request conflicts with the current state of the resource, ie a name or port that is already in use."
+	case e.StatusCode == http.StatusUnprocessableEntity:
+		hint = "Coolify rejected the request."
+	case e.StatusCode >= http.StatusInternalServerError:
+		hint = "Coolify failed to handle the request. It may succeed when retried later; the Coolify logs hold more details."
+	}
+
+	lines := []string{fmt.Sprintf("Received %s.", e.Status)}
+	if hint != "" {
+		lines[0] += " " + hint
+	}
+	if e.Message != "" {
+		lines = append(lines, "Message: "+e.Message)
+	}
+	for _, field := range e.FieldNames() {
+		lines = append(lines, fmt.Sprintf("- %s: %s", field, strings.Join(e.Fields[field], " ")))
+	}
+	if e.Body != "" {
+		lines = append(lines, "Details: "+e.Body)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// FieldNames returns the names of the fields with validation errors in a stable order.
+func (e *Error) FieldNames() []string {
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+	return value[:length] + "..."
+}
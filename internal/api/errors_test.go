@@ -0,0 +1,139 @@
+package api_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestDecodeError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		message    string
+		fields     map[string][]string
+		rawBody    string
+	}{
+		{
+			name:       "ValidationEnvelope",
+			statusCode: http.StatusUnprocessableEntity,
+			body:       `{"message":"Validation failed.","errors":{"postgres_user":["The postgres user field must be a string."],"name":"The name field is required."}}`,
+			message:    "Validation failed.",
+			fields: map[string][]string{
+				"postgres_user": {"The postgres user field must be a string."},
+				"name":          {"The name field is required."},
+			},
+		},
+		{
+			name:       "MessageEnvelope",
+			statusCode: http.StatusNotFound,
+			body:       `{"message":"Database not found."}`,
+			message:    "Database not found.",
+		},
+		{
+			name:       "ErrorEnvelope",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"error":"Unauthenticated."}`,
+			message:    "Unauthenticated.",
+		},
+		{
+			name:       "PlainText",
+			statusCode: http.StatusBadGateway,
+			body:       "<html>Bad Gateway</html>\n",
+			rawBody:    "<html>Bad Gateway</html>",
+		},
+		{
+			name:       "UnknownJSON",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"exception":"RuntimeException"}`,
+			rawBody:    `{"exception":"RuntimeException"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.statusCode, Status: http.StatusText(tt.statusCode)}
+			apiErr := api.DecodeError(resp, []byte(tt.body))
+
+			if apiErr.StatusCode != tt.statusCode {
+				t.Errorf("expected status code %d, got %d", tt.statusCode, apiErr.StatusCode)
+			}
+			if apiErr.Message != tt.message {
+				t.Errorf("expected message %q, got %q", tt.message, apiErr.Message)
+			}
+			if apiErr.Body != tt.rawBody {
+				t.Errorf("expected body %q, got %q", tt.rawBody, apiErr.Body)
+			}
+			if len(apiErr.Fields) != len(tt.fields) {
+				t.Fatalf("expected %d fields, got %v", len(tt.fields), apiErr.Fields)
+			}
+			for field, messages := range tt.fields {
+				if strings.Join(apiErr.Fields[field], "|") != strings.Join(messages, "|") {
+					t.Errorf("expected %v for %s, got %v", messages, field, apiErr.Fields[field])
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeErrorTruncatesBody(t *testing.T) {
+	apiErr := api.DecodeError(nil, []byte(strings.Repeat("x", 1000)))
+
+	if len(apiErr.Body) != 503 || !strings.HasSuffix(apiErr.Body, "...") {
+		t.Errorf("expected a truncated body, got %d characters", len(apiErr.Body))
+	}
+	if apiErr.Status != "no response" {
+		t.Errorf("expected no status, got %q", apiErr.Status)
+	}
+}
+
+func TestErrorSummary(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		expected   string
+	}{
+		{http.StatusUnauthorized, "Unauthorized creating project"},
+		{http.StatusForbidden, "Permission denied creating project"},
+		{http.StatusNotFound, "Not found creating project"},
+		{http.StatusConflict, "Conflict creating project"},
+		{http.StatusUnprocessableEntity, "Invalid attributes creating project"},
+		{http.StatusInternalServerError, "Coolify server error creating project"},
+		{http.StatusServiceUnavailable, "Coolify server error creating project"},
+		{http.StatusBadRequest, "Unexpected HTTP status code creating project"},
+		{http.StatusOK, "Unexpected HTTP status code creating project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			apiErr := api.DecodeError(&http.Response{StatusCode: tt.statusCode}, nil)
+			if got := apiErr.Summary("creating project"); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestErrorDetail(t *testing.T) {
+	apiErr := api.DecodeError(
+		&http.Response{StatusCode: http.StatusUnprocessableEntity, Status: "422 Unprocessable Content"},
+		[]byte(`{"message":"Validation failed.","errors":{"public_port":["The public port has already been taken."],"image":["The image field is invalid."]}}`),
+	)
+
+	expected := "Received 422 Unprocessable Content. Coolify rejected the request.\n" +
+		"Message: Validation failed.\n" +
+		"- image: The image field is invalid.\n" +
+		"- public_port: The public port has already been taken."
+	if got := apiErr.Detail(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if !strings.Contains(api.DecodeError(&http.Response{StatusCode: http.StatusUnauthorized}, nil).Detail(), "COOLIFY_TOKEN") {
+		t.Error("expected the detail of a 401 to mention the token")
+	}
+	if !api.DecodeError(&http.Response{StatusCode: http.StatusNotFound}, nil).IsNotFound() {
+		t.Error("expected a 404 to be reported as not found")
+	}
+}
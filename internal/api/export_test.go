@@ -0,0 +1,20 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+// SetAttemptTimeout overrides the timeout of each attempt for the clients created by the test.
+func SetAttemptTimeout(t *testing.T, timeout time.Duration) {
+	previous := attemptTimeout
+	attemptTimeout = timeout
+	t.Cleanup(func() { attemptTimeout = previous })
+}
+
+// SetMaxRetryAfter overrides the maximum wait asked by a Retry-After header for the test.
+func SetMaxRetryAfter(t *testing.T, wait time.Duration) {
+	previous := maxRetryAfter
+	maxRetryAfter = wait
+	t.Cleanup(func() { maxRetryAfter = previous })
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// LimitConfig throttles the requests sent to Coolify. Zero values disable a limit.
+type LimitConfig struct {
+	RequestsPerSecond     float64
+	Burst                 int64
+	MaxConcurrentRequests int64
+}
+
+// limitTransport enforces the rate limit and the concurrency cap on every attempt of every request,
+// as all resources and data sources of the provider share the same client.
+type limitTransport struct {
+	base    http.RoundTripper
+	limiter *rateLimiter
+	slots   chan struct{}
+}
+
+func newLimitTransport(limit LimitConfig, base http.RoundTripper) http.RoundTripper {
+	if limit.RequestsPerSecond <= 0 && limit.MaxConcurrentRequests <= 0 {
+		return base
+	}
+
+	transport := &limitTransport{base: base}
+	if limit.RequestsPerSecond > 0 {
+		transport.limiter = newRateLimiter(limit.RequestsPerSecond, limit.Burst)
+	}
+	if limit.MaxConcurrentRequests > 0 {
+		transport.slots = make(chan struct{}, limit.MaxConcurrentRequests)
+	}
+	return transport
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, &notSentError{err: ctx.Err()}
+		}
+	}
+	release := sync.OnceFunc(func() {
+		if t.slots != nil {
+			<-t.slots
+		}
+	})
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, &notSentError{err: err}
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// The request is in flight until its body is read and closed
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// notSentError is returned when a request is cancelled while it waits for the rate limit or a free slot,
+// so it is known that Coolify did not receive it.
+type notSentError struct {
+	err error
+}
+
+func (e *notSentError) Error() string {
+	return "request not sent: " + e.err.Error()
+}
+
+func (e *notSentError) Unwrap() error {
+	return e.err
+}
+
+// timeoutTransport limits the time of each attempt, from sending the request until its body is closed.
+type timeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: cancel}
+	return resp, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+// rateLimiter is a token bucket: it holds up to burst requests, and refills at rate requests per second.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int64) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait takes a token, and blocks until it is available.
+// Tokens are handed out in order, so waiting requests are not starved by new ones.
+// The token is given back when ctx is done before it is available.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
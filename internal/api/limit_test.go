@@ -0,0 +1,180 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestRateLimit(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(MockHandler))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{}, api.LimitConfig{
+		RequestsPerSecond: 20,
+		Burst:             2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	// The burst is sent at once, the next 4 requests wait 50ms each
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if _, err := client.VersionWithResponse(context.Background()); err != nil {
+			t.Fatalf("Failed to get version: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Errorf("Expected the requests to be rate limited, took %s", elapsed)
+	}
+}
+
+func TestRateLimitCancelled(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(MockHandler))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{}, api.LimitConfig{
+		RequestsPerSecond: 0.1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	if _, err := client.VersionWithResponse(context.Background()); err != nil {
+		t.Fatalf("Failed to get version: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.CreateProjectWithResponse(ctx, api.CreateProjectJSONRequestBody{})
+	if err == nil {
+		t.Fatal("Expected an error when the context is cancelled while waiting for the rate limit")
+	}
+	// The request was never sent, so creates are not reconciled
+	if !api.IsConnectionError(err) {
+		t.Errorf("Expected the cancelled wait to be reported as not sent, got %v", err)
+	}
+}
+
+func TestRateLimitCancelledReturnsToken(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(MockHandler))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{}, api.LimitConfig{
+		RequestsPerSecond: 10,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	if _, err := client.VersionWithResponse(context.Background()); err != nil {
+		t.Fatalf("Failed to get version: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.VersionWithResponse(ctx); err == nil {
+		t.Fatal("Expected an error when the context is cancelled while waiting for the rate limit")
+	}
+
+	// Without the token of the cancelled request, the next one waits for a single token instead of two
+	start := time.Now()
+	if _, err := client.VersionWithResponse(context.Background()); err != nil {
+		t.Fatalf("Failed to get version: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("Expected the cancelled request to give its token back, waited %s", elapsed)
+	}
+}
+
+func TestRateLimitWaitIsNotPartOfTheTimeout(t *testing.T) {
+	api.SetAttemptTimeout(t, 100*time.Millisecond)
+
+	mockServer := httptest.NewServer(http.HandlerFunc(MockHandler))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{}, api.LimitConfig{
+		RequestsPerSecond: 4,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	// The second request waits 250ms for the rate limit, longer than the timeout of an attempt
+	for i := 0; i < 2; i++ {
+		if _, err := client.VersionWithResponse(context.Background()); err != nil {
+			t.Fatalf("Expected the wait for the rate limit not to count towards the timeout, got error: %v", err)
+		}
+	}
+}
+
+func TestAttemptTimeout(t *testing.T) {
+	api.SetAttemptTimeout(t, 50*time.Millisecond)
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		MockHandler(w, r)
+	}))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{}, api.LimitConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	_, err = client.CreateProjectWithResponse(context.Background(), api.CreateProjectJSONRequestBody{})
+	if err == nil {
+		t.Fatal("Expected the attempt to time out")
+	}
+	// Coolify may have received the request
+	if api.IsConnectionError(err) {
+		t.Errorf("Expected a timeout after sending to be ambiguous, got %v", err)
+	}
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		MockHandler(w, r)
+	}))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{}, api.LimitConfig{
+		MaxConcurrentRequests: 2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.VersionWithResponse(context.Background()); err != nil {
+				t.Errorf("Failed to get version: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got != 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", got)
+	}
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// retryTransport retries requests according to their method.
+// A POST creates a resource, so it is only retried when Coolify did not process it:
+// retrying after a timeout or a server error could create the resource a second time.
+type retryTransport struct {
+	idempotent    http.RoundTripper
+	nonIdempotent http.RoundTripper
+}
+
+// newRetryTransport retries the attempts sent with httpClient, which both retry policies share.
+func newRetryTransport(retry RetryConfig, httpClient *http.Client) *retryTransport {
+	return &retryTransport{
+		idempotent:    newRetryClient(retry, httpClient, retryablehttp.DefaultRetryPolicy).StandardClient().Transport,
+		nonIdempotent: newRetryClient(retry, httpClient, retryBeforeSendPolicy).StandardClient().Transport,
+	}
+}
+
+func newRetryClient(retry RetryConfig, httpClient *http.Client, policy retryablehttp.CheckRetry) *retryablehttp.Client {
+	retryClient := retryablehttp.NewClient()
+	retryClient.HTTPClient = httpClient
+	retryClient.RetryMax = int(retry.MaxAttempts)
+	retryClient.RetryWaitMin = time.Duration(retry.MinWait) * time.Second
+	retryClient.RetryWaitMax = time.Duration(retry.MaxWait) * time.Second
+	retryClient.Backoff = retryAfterBackoff
+	retryClient.CheckRetry = policy
+	retryClient.Logger = nil
+	return retryClient
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost {
+		return t.nonIdempotent.RoundTrip(req)
+	}
+	return t.idempotent.RoundTrip(req)
+}
+
+// retryBeforeSendPolicy only retries connection failures that happen before the request is sent,
+// and rate limited requests, which Coolify rejects before processing them.
+// Any other response, including a server error, is returned as is.
+func retryBeforeSendPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		// The error of the attempt tells whether the request was sent, so it is kept when there is one
+		if err != nil {
+			return false, err
+		}
+		return false, ctx.Err()
+	}
+	if err != nil {
+		return IsConnectionError(err), nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests, nil
+}
+
+// maxRetryAfter caps the wait asked by a Retry-After header, so a misconfigured proxy can not stall an apply for hours.
+var maxRetryAfter = 5 * time.Minute
+
+// retryAfterBackoff waits as long as the Retry-After header of a rate limited or unavailable response asks,
+// and backs off exponentially between the minimum and maximum wait otherwise.
+// Retry-After is honoured even when it is longer than the maximum wait, as retrying earlier is rejected again,
+// up to maxRetryAfter.
+func retryAfterBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		if wait > maxRetryAfter {
+			return maxRetryAfter
+		}
+		return wait
+	}
+	return retryablehttp.DefaultBackoff(min, max, attemptNum, nil)
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// IsConnectionError reports whether the request failed before it was sent to Coolify,
+// ie because the host could not be resolved or refused the connection,
+// or the request was cancelled while waiting for the rate limit or a free slot.
+// Any other error is ambiguous: Coolify may have received and processed the request.
+func IsConnectionError(err error) bool {
+	var notSent *notSentError
+	if errors.As(err, &notSent) {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,154 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestRetryPolicyByMethod(t *testing.T) {
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts[r.Method]++
+		mu.Unlock()
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{MaxAttempts: 2}, api.LimitConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	if _, err := client.VersionWithResponse(context.Background()); err == nil {
+		t.Error("Expected an error after retrying a GET request")
+	}
+	if attempts[http.MethodGet] != 3 {
+		t.Errorf("Expected 3 GET attempts, got %d", attempts[http.MethodGet])
+	}
+
+	resp, err := client.CreateProjectWithResponse(context.Background(), api.CreateProjectJSONRequestBody{})
+	if err != nil {
+		t.Fatalf("Expected the response of the POST request, got error: %v", err)
+	}
+	if resp.StatusCode() != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, resp.StatusCode())
+	}
+	if attempts[http.MethodPost] != 1 {
+		t.Errorf("Expected 1 POST attempt, got %d", attempts[http.MethodPost])
+	}
+}
+
+func TestRetryPostOnConnectionError(t *testing.T) {
+	// Reserve an address and close it, so connections are refused before anything is sent
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	client, err := api.NewAPIClient("test", "http://"+address, MOCK_TOKEN, api.RetryConfig{MaxAttempts: 1}, api.LimitConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	_, err = client.CreateProjectWithResponse(context.Background(), api.CreateProjectJSONRequestBody{})
+	if err == nil {
+		t.Fatal("Expected an error for a refused connection")
+	}
+	if !api.IsConnectionError(err) {
+		t.Errorf("Expected a connection error, got: %v", err)
+	}
+}
+
+func TestIsConnectionError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"Dial", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"DNS", &net.DNSError{Err: "no such host", Name: "coolify.invalid"}, true},
+		{"Read", &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}, false},
+		{"Timeout", context.DeadlineExceeded, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := api.IsConnectionError(tt.err); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{MaxAttempts: 1}, api.LimitConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	start := time.Now()
+	resp, err := client.CreateProjectWithResponse(context.Background(), api.CreateProjectJSONRequestBody{})
+	if err != nil {
+		t.Fatalf("Expected the rate limited POST request to be retried, got error: %v", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		t.Errorf("Expected status %d, got %d", http.StatusCreated, resp.StatusCode())
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected to wait for the Retry-After header, retried after %s", elapsed)
+	}
+}
+
+func TestRetryAfterIsCapped(t *testing.T) {
+	api.SetMaxRetryAfter(t, 50*time.Millisecond)
+
+	var attempts atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer mockServer.Close()
+
+	client, err := api.NewAPIClient("test", mockServer.URL, MOCK_TOKEN, api.RetryConfig{MaxAttempts: 1}, api.LimitConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create API client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := client.CreateProjectWithResponse(ctx, api.CreateProjectJSONRequestBody{})
+	if err != nil {
+		t.Fatalf("Expected the Retry-After wait to be capped, got error: %v", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		t.Errorf("Expected status %d, got %d", http.StatusCreated, resp.StatusCode())
+	}
+}
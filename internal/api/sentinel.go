@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SentinelClient reads metrics directly from the Sentinel agent running on a server.
+// The Coolify API does not expose metrics, so the agent must be reachable from Terraform.
+type SentinelClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// ErrSentinelMetricUnavailable is returned when the Sentinel agent does not collect a metric, ie older agents without disk metrics.
+var ErrSentinelMetricUnavailable = errors.New("metric not collected by the Sentinel agent")
+
+// SentinelMetric is a single data point returned by the Sentinel agent.
+// Percent is nil when the data point has no value.
+type SentinelMetric struct {
+	Time    time.Time
+	Percent *float64
+}
+
+func NewSentinelClient(baseURL, token string) *SentinelClient {
+	return &SentinelClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// CPUHistory returns the CPU usage percentages collected since `from`.
+func (c *SentinelClient) CPUHistory(ctx context.Context, from time.Time) ([]SentinelMetric, error) {
+	return c.history(ctx, "/api/cpu/history", "percent", from)
+}
+
+// MemoryHistory returns the memory usage percentages collected since `from`.
+func (c *SentinelClient) MemoryHistory(ctx context.Context, from time.Time) ([]SentinelMetric, error) {
+	return c.history(ctx, "/api/memory/history", "usedPercent", from)
+}
+
+// DiskHistory returns the usage percentages of the root filesystem collected since `from`.
+func (c *SentinelClient) DiskHistory(ctx context.Context, from time.Time) ([]SentinelMetric, error) {
+	return c.history(ctx, "/api/disk/history", "usedPercent", from)
+}
+
+func (c *SentinelClient) history(ctx context.Context, endpoint, valueKey string, from time.Time) ([]SentinelMetric, error) {
+	query := url.Values{"from": {from.UTC().Format(time.RFC3339)}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: received %s from %s", ErrSentinelMetricUnavailable, resp.Status, endpoint)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received %s from %s. Details: %s", resp.Status, endpoint, body)
+	}
+
+	var raw []map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("unable to decode response from %s: %w", endpoint, err)
+	}
+
+	metrics := make([]SentinelMetric, 0, len(raw))
+	for _, point := range raw {
+		timestamp, err := parseSentinelTime(point["time"])
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode time from %s: %w", endpoint, err)
+		}
+		value, err := parseSentinelNumber(point[valueKey])
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode %s from %s: %w", valueKey, endpoint, err)
+		}
+		metrics = append(metrics, SentinelMetric{Time: timestamp, Percent: value})
+	}
+
+	return metrics, nil
+}
+
+// parseSentinelTime accepts Unix milliseconds, either as a number or a string, or an RFC3339 string.
+func parseSentinelTime(value json.RawMessage) (time.Time, error) {
+	var text string
+	if err := json.Unmarshal(value, &text); err != nil {
+		text = string(value)
+	}
+
+	if millis, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return time.UnixMilli(millis).UTC(), nil
+	}
+
+	return time.Parse(time.RFC3339, text)
+}
+
+// parseSentinelNumber accepts a number, or a number encoded as a string.
+// A missing or null value is returned as nil, so it is not mistaken for 0%.
+func parseSentinelNumber(value json.RawMessage) (*float64, error) {
+	if value == nil || string(value) == "null" {
+		return nil, nil
+	}
+
+	var text string
+	if err := json.Unmarshal(value, &text); err != nil {
+		text = string(value)
+	}
+
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &number, nil
+}
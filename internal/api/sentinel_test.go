@@ -0,0 +1,81 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+const MOCK_SENTINEL_TOKEN = "sentinelToken"
+
+func MockSentinelHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+MOCK_SENTINEL_TOKEN {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Query().Get("from") == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/api/cpu/history":
+		w.Write([]byte(`[{"time":1700000000000,"percent":"12.5"},{"time":"1700000060000","percent":25}]`))
+	case "/api/memory/history":
+		w.Write([]byte(`[{"time":"2023-11-14T22:14:20Z","usedPercent":42.1},{"time":"2023-11-14T22:15:20Z"}]`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestSentinelClient(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(MockSentinelHandler))
+	defer mockServer.Close()
+
+	ctx := context.Background()
+	from := time.Now().Add(-5 * time.Minute)
+	client := api.NewSentinelClient(mockServer.URL+"/", MOCK_SENTINEL_TOKEN)
+
+	cpu, err := client.CPUHistory(ctx, from)
+	if err != nil {
+		t.Fatalf("Failed to get CPU history: %v", err)
+	}
+	if len(cpu) != 2 {
+		t.Fatalf("Expected 2 CPU metrics, got %d", len(cpu))
+	}
+	if cpu[0].Percent == nil || *cpu[0].Percent != 12.5 || !cpu[0].Time.Equal(time.UnixMilli(1700000000000)) {
+		t.Errorf("Unexpected CPU metric: %+v", cpu[0])
+	}
+	if cpu[1].Percent == nil || *cpu[1].Percent != 25 || !cpu[1].Time.Equal(time.UnixMilli(1700000060000)) {
+		t.Errorf("Unexpected CPU metric: %+v", cpu[1])
+	}
+
+	memory, err := client.MemoryHistory(ctx, from)
+	if err != nil {
+		t.Fatalf("Failed to get memory history: %v", err)
+	}
+	if len(memory) != 2 || memory[0].Percent == nil || *memory[0].Percent != 42.1 {
+		t.Errorf("Unexpected memory metrics: %+v", memory)
+	}
+	if len(memory) == 2 && memory[1].Percent != nil {
+		t.Errorf("Expected a missing value to be nil, got %v", *memory[1].Percent)
+	}
+
+	// Test with an agent that does not collect disk metrics
+	_, err = client.DiskHistory(ctx, from)
+	if !errors.Is(err, api.ErrSentinelMetricUnavailable) {
+		t.Errorf("Expected unavailable disk metrics, got: %v", err)
+	}
+
+	// Test with invalid token
+	_, err = api.NewSentinelClient(mockServer.URL, "invalid_token").CPUHistory(ctx, from)
+	if err == nil {
+		t.Fatalf("Expected error when reading metrics with invalid token, got none")
+	}
+}
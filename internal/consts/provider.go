@@ -10,4 +10,9 @@ const (
 	DEFAULT_RETRY_ATTEMPTS = 4
 	DEFAULT_RETRY_MIN_WAIT = 1
 	DEFAULT_RETRY_MAX_WAIT = 30
+
+	DEFAULT_SERVER_READY_TIMEOUT  = 300
+	DEFAULT_SERVER_METRICS_WINDOW = 5
+	DEFAULT_FORCE_DESTROY_TIMEOUT = 300
+	DEFAULT_ACTION_TIMEOUT        = 600
 )
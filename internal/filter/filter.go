@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -16,6 +17,7 @@ import (
 type BlockModel struct {
 	Name   types.String `tfsdk:"name"`
 	Values types.List   `tfsdk:"values"`
+	Match  types.String `tfsdk:"match"`
 }
 
 // CreateDatasourceFilter creates a filter block for a datasource schema.
@@ -33,30 +35,45 @@ func CreateDatasourceFilter(allowedFields []string) schema.Block {
 				},
 				"values": schema.ListAttribute{
 					Required:            true,
-					MarkdownDescription: "List of values to match against - if any value matches, the filter is satisfied (**OR** operation). Non-string values will be converted to strings if possible, ie `true` -> `\"true\"`",
+					MarkdownDescription: "List of values to match against - if any value matches, the filter is satisfied (**OR** operation). With `match = \"not\"`, the filter is satisfied when no value matches. Non-string values will be converted to strings if possible, ie `true` -> `\"true\"`",
 					ElementType:         types.StringType,
+					Validators:          []validator.List{filterValuesValidator{}},
+				},
+				"match": schema.StringAttribute{
+					Optional: true,
+					MarkdownDescription: "How values are matched. Defaults to `exact`.\n" +
+						"  - `exact`: equal to a value\n" +
+						"  - `regex`: matches a regular expression, use `^` and `$` to anchor it\n" +
+						"  - `glob`: matches a pattern, where `*` matches any characters and `?` a single character\n" +
+						"  - `prefix`: starts with a value\n" +
+						"  - `not`: equal to none of the values\n" +
+						"  - `gt` / `lt`: greater / less than a number or an RFC3339 time",
+					Validators: []validator.String{
+						stringvalidator.OneOf(matchOperators...),
+					},
 				},
 			},
 		},
 	}
 }
 
-// OnAttributes filters a map of attributes based on a list of filter blocks.
-func OnAttributes(attributes map[string]attr.Value, filters []BlockModel) bool {
-	if len(filters) == 0 {
-		return true
-	}
+// Filters are filter blocks prepared for matching many items.
+type Filters []compiledFilter
 
+// Compile prepares the filter blocks for matching, so their values are read and their patterns compiled once for all items.
+func Compile(ctx context.Context, filters []BlockModel) Filters {
+	compiled := make(Filters, 0, len(filters))
 	for _, filter := range filters {
-		if attr, ok := attributes[filter.Name.ValueString()]; ok {
-			attrValueString := attributeValueToString(attr)
-
-			filterValues := []string{}
-			filter.Values.ElementsAs(context.Background(), &filterValues, false)
-			if !slices.Contains(filterValues, attrValueString) {
-				return false
-			}
-		} else {
+		compiled = append(compiled, compile(ctx, filter))
+	}
+	return compiled
+}
+
+// OnAttributes reports whether the attributes satisfy every filter.
+func (f Filters) OnAttributes(attributes map[string]attr.Value) bool {
+	for _, filter := range f {
+		attr, ok := lookupAttribute(attributes, filter.name)
+		if !ok || !matches(attr, filter) {
 			return false
 		}
 	}
@@ -64,6 +81,15 @@ func OnAttributes(attributes map[string]attr.Value, filters []BlockModel) bool {
 	return true
 }
 
+// OnStruct reports whether the attributes of the item satisfy every filter.
+func (f Filters) OnStruct(item FilterableStructModel) bool {
+	if len(f) == 0 {
+		return true
+	}
+
+	return f.OnAttributes(item.FilterAttributes())
+}
+
 // attributeValueToString converts any supported attribute value to its string representation.
 func attributeValueToString(value attr.Value) string {
 	switch v := value.(type) {
@@ -95,31 +121,69 @@ type FilterableStructModel interface {
 	FilterAttributes() map[string]attr.Value
 }
 
-func OnStruct(
-	ctx context.Context,
-	item FilterableStructModel,
-	filters []BlockModel,
-) bool {
-	if len(filters) == 0 {
-		return true
+// FromLookup creates exact match filters from the lookup attributes that are set.
+func FromLookup(lookup map[string]attr.Value) []BlockModel {
+	names := make([]string, 0, len(lookup))
+	for name, value := range lookup {
+		if !value.IsNull() && !value.IsUnknown() {
+			names = append(names, name)
+		}
 	}
+	slices.Sort(names)
+
+	filters := make([]BlockModel, 0, len(names))
+	for _, name := range names {
+		filters = append(filters, BlockModel{
+			Name:   types.StringValue(name),
+			Values: types.ListValueMust(types.StringType, []attr.Value{types.StringValue(attributeValueToString(lookup[name]))}),
+		})
+	}
+	return filters
+}
 
-	attributes := item.FilterAttributes()
+// SelectOne returns the only item that matches.
+// It adds an error describing the lookup when no item or more than one item matches.
+func SelectOne[T any](
+	diags *diag.Diagnostics,
+	kind string,
+	lookup []BlockModel,
+	items []T,
+	match func(item T) bool,
+) (T, bool) {
+	var selected T
+	count := 0
+	for _, item := range items {
+		if match(item) {
+			selected = item
+			count++
+		}
+	}
 
-	for _, filter := range filters {
-		filterName := filter.Name.ValueString()
-		filterValues := []string{}
-		filter.Values.ElementsAs(ctx, &filterValues, false)
+	switch count {
+	case 1:
+		return selected, true
+	case 0:
+		diags.AddError(
+			fmt.Sprintf("No matching %s found", kind),
+			fmt.Sprintf("No %s matches %s.", kind, describeLookup(lookup)),
+		)
+	default:
+		diags.AddError(
+			fmt.Sprintf("Ambiguous %s lookup", kind),
+			fmt.Sprintf("Found %d matches for %s, but exactly one %s is required. Use a more specific lookup, ie `uuid`.", count, describeLookup(lookup), kind),
+		)
+	}
 
-		attrValue, ok := attributes[filterName]
-		if !ok {
-			return false
-		}
+	var empty T
+	return empty, false
+}
 
-		attrValueString := attributeValueToString(attrValue)
-		if !slices.Contains(filterValues, attrValueString) {
-			return false
-		}
+func describeLookup(lookup []BlockModel) string {
+	parts := make([]string, 0, len(lookup))
+	for _, filter := range lookup {
+		filterValues := []string{}
+		filter.Values.ElementsAs(context.Background(), &filterValues, false)
+		parts = append(parts, fmt.Sprintf("%s = %q", filter.Name.ValueString(), strings.Join(filterValues, ", ")))
 	}
-	return true
+	return strings.Join(parts, " and ")
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -176,7 +177,7 @@ func TestOnAttributes(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := OnAttributes(tt.attributes, tt.filters)
+			result := Compile(context.Background(), tt.filters).OnAttributes(tt.attributes)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -333,7 +334,7 @@ func TestFilterOnStruct(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := OnStruct(context.Background(), tt.item, tt.filters)
+			result := Compile(context.Background(), tt.filters).OnStruct(tt.item)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -350,3 +351,48 @@ func TestCreateDatasourceFilter(t *testing.T) {
 	assert.Contains(t, attributes, "name", "Block should contain 'name' attribute")
 	assert.Contains(t, attributes, "values", "Block should contain 'values' attribute")
 }
+
+func TestFromLookup(t *testing.T) {
+	filters := FromLookup(map[string]attr.Value{
+		"name": types.StringValue("example"),
+		"ip":   types.StringNull(),
+		"port": types.Int64Value(22),
+	})
+
+	assert.Len(t, filters, 2)
+	assert.Equal(t, "name", filters[0].Name.ValueString())
+	assert.Equal(t, "port", filters[1].Name.ValueString())
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("22")}), filters[1].Values)
+}
+
+func TestSelectOne(t *testing.T) {
+	lookup := FromLookup(map[string]attr.Value{"name": types.StringValue("a")})
+	match := func(item string) bool { return item == "a" }
+
+	tests := []struct {
+		name          string
+		items         []string
+		expectedOk    bool
+		expectedError string
+	}{
+		{"Single", []string{"a", "b"}, true, ""},
+		{"None", []string{"b", "c"}, false, "No matching item found"},
+		{"Multiple", []string{"a", "b", "a"}, false, "Ambiguous item lookup"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			item, ok := SelectOne(&diags, "item", lookup, tt.items, match)
+
+			assert.Equal(t, tt.expectedOk, ok)
+			if tt.expectedOk {
+				assert.Equal(t, "a", item)
+				assert.False(t, diags.HasError())
+			} else {
+				assert.Equal(t, tt.expectedError, diags.Errors()[0].Summary())
+				assert.Contains(t, diags.Errors()[0].Detail(), `name = "a"`)
+			}
+		})
+	}
+}
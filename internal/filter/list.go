@@ -0,0 +1,82 @@
+package filter
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// ListResourceModel holds the query arguments of a list resource.
+type ListResourceModel struct {
+	Filter []BlockModel `tfsdk:"filter"`
+	SortModel
+}
+
+// CreateListResourceSchema creates the schema of a list resource, with the same filter and sort arguments as a list datasource.
+// Filter attributes which can not be converted are reported in diags.
+func CreateListResourceSchema(diags *diag.Diagnostics, description string, allowedFields []string, mostRecentField string) listschema.Schema {
+	filterBlock := CreateDatasourceFilter(allowedFields).(schema.ListNestedBlock)
+
+	s := listschema.Schema{
+		Description: description,
+		Attributes:  map[string]listschema.Attribute{},
+		Blocks: map[string]listschema.Block{
+			"filter": listschema.ListNestedBlock{
+				MarkdownDescription: filterBlock.MarkdownDescription,
+				NestedObject: listschema.NestedBlockObject{
+					Attributes: listAttributes(diags, filterBlock.NestedObject.Attributes),
+				},
+			},
+		},
+	}
+	for name, attr := range listAttributes(diags, CreateDatasourceSort(allowedFields, mostRecentField)) {
+		s.Attributes[name] = attr
+	}
+	return s
+}
+
+// listAttributes converts the datasource attributes used by filters to list resource attributes.
+func listAttributes(diags *diag.Diagnostics, attributes map[string]schema.Attribute) map[string]listschema.Attribute {
+	converted := make(map[string]listschema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		switch typedAttr := attribute.(type) {
+		case schema.StringAttribute:
+			converted[name] = listschema.StringAttribute{
+				Required:            typedAttr.Required,
+				Optional:            typedAttr.Optional,
+				MarkdownDescription: typedAttr.MarkdownDescription,
+				Validators:          typedAttr.Validators,
+			}
+		case schema.BoolAttribute:
+			converted[name] = listschema.BoolAttribute{
+				Required:            typedAttr.Required,
+				Optional:            typedAttr.Optional,
+				MarkdownDescription: typedAttr.MarkdownDescription,
+				Validators:          typedAttr.Validators,
+			}
+		case schema.Int64Attribute:
+			converted[name] = listschema.Int64Attribute{
+				Required:            typedAttr.Required,
+				Optional:            typedAttr.Optional,
+				MarkdownDescription: typedAttr.MarkdownDescription,
+				Validators:          typedAttr.Validators,
+			}
+		case schema.ListAttribute:
+			converted[name] = listschema.ListAttribute{
+				ElementType:         typedAttr.ElementType,
+				Required:            typedAttr.Required,
+				Optional:            typedAttr.Optional,
+				MarkdownDescription: typedAttr.MarkdownDescription,
+				Validators:          typedAttr.Validators,
+			}
+		default:
+			diags.AddError(
+				"Unsupported filter attribute",
+				fmt.Sprintf("Filter attribute %s of type %T is not supported by list resources.", name, attribute),
+			)
+		}
+	}
+	return converted
+}
@@ -0,0 +1,59 @@
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateListResourceSchema(t *testing.T) {
+	var diags diag.Diagnostics
+	s := CreateListResourceSchema(&diags, "List things.", []string{"name", "uuid"}, "created_at")
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "List things.", s.Description)
+	assert.ElementsMatch(t, []string{"sort_by", "order", "limit", "most_recent"}, keys(s.Attributes))
+
+	sortBy, ok := s.Attributes["sort_by"].(listschema.StringAttribute)
+	require.True(t, ok)
+	assert.True(t, sortBy.Optional)
+	assert.Len(t, sortBy.Validators, 1)
+	assert.Contains(t, sortBy.MarkdownDescription, "`name`, `uuid`")
+
+	filterBlock, ok := s.Blocks["filter"].(listschema.ListNestedBlock)
+	require.True(t, ok)
+	assert.ElementsMatch(t, []string{"name", "values", "match"}, keys(filterBlock.NestedObject.Attributes))
+
+	values, ok := filterBlock.NestedObject.Attributes["values"].(listschema.ListAttribute)
+	require.True(t, ok)
+	assert.True(t, values.Required)
+	assert.Len(t, values.Validators, 1)
+
+	// The converted schema must be valid for the framework
+	assert.False(t, s.ValidateImplementation(context.Background()).HasError())
+}
+
+func TestListAttributesUnsupported(t *testing.T) {
+	var diags diag.Diagnostics
+	converted := listAttributes(&diags, map[string]schema.Attribute{
+		"name":   schema.StringAttribute{Optional: true},
+		"weight": schema.Float64Attribute{Optional: true},
+	})
+
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "weight")
+	assert.ElementsMatch(t, []string{"name"}, keys(converted))
+}
+
+func keys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
@@ -0,0 +1,181 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Match operators of a filter block.
+const (
+	MatchExact  = "exact"
+	MatchRegex  = "regex"
+	MatchGlob   = "glob"
+	MatchPrefix = "prefix"
+	MatchNot    = "not"
+	MatchGt     = "gt"
+	MatchLt     = "lt"
+)
+
+var matchOperators = []string{MatchExact, MatchRegex, MatchGlob, MatchPrefix, MatchNot, MatchGt, MatchLt}
+
+// compiledFilter is a filter block prepared for matching, with its values read and its patterns compiled once.
+type compiledFilter struct {
+	name   string
+	match  string
+	values []string
+	// patterns are the compiled values of a `regex` or `glob` filter.
+	patterns []*regexp.Regexp
+}
+
+// compile prepares the filter block for matching.
+// Regular expressions are validated during plan by filterValuesValidator.
+func compile(ctx context.Context, filter BlockModel) compiledFilter {
+	compiled := compiledFilter{
+		name:   filter.Name.ValueString(),
+		match:  filter.Match.ValueString(),
+		values: []string{},
+	}
+	filter.Values.ElementsAs(ctx, &compiled.values, false)
+
+	switch compiled.match {
+	case MatchRegex:
+		for _, pattern := range compiled.values {
+			compiled.patterns = append(compiled.patterns, regexp.MustCompile(pattern))
+		}
+	case MatchGlob:
+		for _, pattern := range compiled.values {
+			compiled.patterns = append(compiled.patterns, globToRegexp(pattern))
+		}
+	}
+	return compiled
+}
+
+// matches reports whether the attribute value satisfies the filter.
+// Every operator except `not` is satisfied when any of the filter values matches.
+func matches(value attr.Value, filter compiledFilter) bool {
+	valueString := attributeValueToString(value)
+
+	switch filter.match {
+	case MatchNot:
+		return !slices.Contains(filter.values, valueString)
+	case MatchRegex, MatchGlob:
+		return slices.ContainsFunc(filter.patterns, func(re *regexp.Regexp) bool {
+			return re.MatchString(valueString)
+		})
+	case MatchPrefix:
+		return slices.ContainsFunc(filter.values, func(prefix string) bool {
+			return strings.HasPrefix(valueString, prefix)
+		})
+	case MatchGt, MatchLt:
+		if value.IsNull() || value.IsUnknown() {
+			return false
+		}
+		return slices.ContainsFunc(filter.values, func(bound string) bool {
+			cmp, ok := compareOrdered(valueString, bound)
+			if !ok {
+				return false
+			}
+			if filter.match == MatchGt {
+				return cmp > 0
+			}
+			return cmp < 0
+		})
+	default: // MatchExact
+		return slices.Contains(filter.values, valueString)
+	}
+}
+
+// compareOrdered compares two values as numbers, or else as RFC3339 times.
+func compareOrdered(a, b string) (int, bool) {
+	aNum, aOk := new(big.Float).SetString(a)
+	bNum, bOk := new(big.Float).SetString(b)
+	if aOk && bOk {
+		return aNum.Cmp(bNum), true
+	}
+
+	aTime, aErr := time.Parse(time.RFC3339, a)
+	bTime, bErr := time.Parse(time.RFC3339, b)
+	if aErr == nil && bErr == nil {
+		return aTime.Compare(bTime), true
+	}
+
+	return 0, false
+}
+
+// globToRegexp converts a glob pattern, where `*` matches any characters and `?` matches a single character, to a regular expression.
+func globToRegexp(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
+
+var _ validator.List = filterValuesValidator{}
+
+// filterValuesValidator validates the filter values against the match operator of the same filter block.
+type filterValuesValidator struct{}
+
+func (v filterValuesValidator) Description(ctx context.Context) string {
+	return "values must be valid regular expressions for `regex`, and numbers or RFC3339 times for `gt` and `lt`"
+}
+
+func (v filterValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v filterValuesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var match types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("match"), &match)...)
+	if resp.Diagnostics.HasError() || match.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		switch match.ValueString() {
+		case MatchRegex:
+			if _, err := regexp.Compile(value.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					req.Path.AtListIndex(i),
+					"Invalid Regular Expression",
+					fmt.Sprintf("Value %q is not a valid regular expression: %s", value.ValueString(), err),
+				)
+			}
+		case MatchGt, MatchLt:
+			if _, ok := compareOrdered(value.ValueString(), value.ValueString()); !ok {
+				resp.Diagnostics.AddAttributeError(
+					req.Path.AtListIndex(i),
+					"Invalid Comparison Value",
+					fmt.Sprintf("Value %q must be a number or an RFC3339 time, ie `2024-01-02T15:04:05Z`, to be used with `%s`.", value.ValueString(), match.ValueString()),
+				)
+			}
+		}
+	}
+}
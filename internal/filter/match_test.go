@@ -0,0 +1,122 @@
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    attr.Value
+		match    string
+		values   []string
+		expected bool
+	}{
+		{"DefaultExact", types.StringValue("api-1"), "", []string{"api-1"}, true},
+		{"Exact", types.StringValue("api-1"), MatchExact, []string{"api-2"}, false},
+		{"Regex", types.StringValue("api-1"), MatchRegex, []string{"^web-", "^api-[0-9]+$"}, true},
+		{"RegexNoMatch", types.StringValue("api-x"), MatchRegex, []string{"^api-[0-9]+$"}, false},
+		{"Glob", types.StringValue("https://app.example.com"), MatchGlob, []string{"*.example.com"}, true},
+		{"GlobSingleCharacter", types.StringValue("api-1"), MatchGlob, []string{"api-?"}, true},
+		{"GlobIsAnchored", types.StringValue("api-1-old"), MatchGlob, []string{"api-?"}, false},
+		{"GlobEscapesRegexp", types.StringValue("a.b"), MatchGlob, []string{"a.b"}, true},
+		{"Prefix", types.StringValue("api-1"), MatchPrefix, []string{"api-"}, true},
+		{"PrefixNoMatch", types.StringValue("web-1"), MatchPrefix, []string{"api-"}, false},
+		{"Not", types.StringValue("running"), MatchNot, []string{"exited", "stopped"}, true},
+		{"NotMatches", types.StringValue("exited"), MatchNot, []string{"exited", "stopped"}, false},
+		{"GtNumber", types.Int64Value(10), MatchGt, []string{"9"}, true},
+		{"GtNumberEqual", types.Int64Value(10), MatchGt, []string{"10"}, false},
+		{"LtNumber", types.Float64Value(1.5), MatchLt, []string{"2"}, true},
+		{"GtTime", types.StringValue("2024-06-01T00:00:00Z"), MatchGt, []string{"2024-01-01T00:00:00Z"}, true},
+		{"LtTime", types.StringValue("2024-06-01T00:00:00Z"), MatchLt, []string{"2024-01-01T00:00:00Z"}, false},
+		{"GtNotComparable", types.StringValue("abc"), MatchGt, []string{"1"}, false},
+		{"GtNull", types.Int64Null(), MatchGt, []string{"1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := []attr.Value{}
+			for _, v := range tt.values {
+				values = append(values, types.StringValue(v))
+			}
+			filter := BlockModel{
+				Name:   types.StringValue("field"),
+				Values: types.ListValueMust(types.StringType, values),
+				Match:  types.StringNull(),
+			}
+			if tt.match != "" {
+				filter.Match = types.StringValue(tt.match)
+			}
+
+			assert.Equal(t, tt.expected, matches(tt.value, compile(context.Background(), filter)))
+		})
+	}
+}
+
+func TestFilterValuesValidator(t *testing.T) {
+	tests := []struct {
+		name          string
+		match         string
+		values        []string
+		expectedError string
+	}{
+		{"Regex", MatchRegex, []string{"^api-[0-9]+$"}, ""},
+		{"InvalidRegex", MatchRegex, []string{"api-("}, "Invalid Regular Expression"},
+		{"GtNumber", MatchGt, []string{"10"}, ""},
+		{"LtTime", MatchLt, []string{"2024-01-01T00:00:00Z"}, ""},
+		{"GtInvalid", MatchGt, []string{"yesterday"}, "Invalid Comparison Value"},
+		{"ExactAnything", MatchExact, []string{"api-("}, ""},
+	}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"values": schema.ListAttribute{ElementType: types.StringType, Required: true},
+			"match":  schema.StringAttribute{Optional: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			values := []tftypes.Value{}
+			listValues := []attr.Value{}
+			for _, v := range tt.values {
+				values = append(values, tftypes.NewValue(tftypes.String, v))
+				listValues = append(listValues, types.StringValue(v))
+			}
+			config := tfsdk.Config{
+				Schema: s,
+				Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"values": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values),
+					"match":  tftypes.NewValue(tftypes.String, tt.match),
+				}),
+			}
+
+			req := validator.ListRequest{
+				Path:        path.Root("values"),
+				ConfigValue: types.ListValueMust(types.StringType, listValues),
+				Config:      config,
+			}
+			resp := &validator.ListResponse{}
+			filterValuesValidator{}.ValidateList(ctx, req, resp)
+
+			if tt.expectedError == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+			} else {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectedError, resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
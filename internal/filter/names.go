@@ -0,0 +1,92 @@
+package filter
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// NamesFromSchema returns the names of the attributes that can be filtered and sorted on.
+// Attributes of nested objects are named with a dotted path, ie `settings.is_reachable`.
+// Collections and sensitive attributes are not supported.
+func NamesFromSchema(attributes map[string]schema.Attribute) []string {
+	var names []string
+	for name, attribute := range attributes {
+		if attribute.IsSensitive() {
+			continue
+		}
+
+		switch typedAttr := attribute.(type) {
+		case schema.StringAttribute, schema.BoolAttribute,
+			schema.Int64Attribute, schema.Int32Attribute,
+			schema.Float64Attribute, schema.Float32Attribute,
+			schema.NumberAttribute:
+			names = append(names, name)
+		case schema.SingleNestedAttribute:
+			for _, nestedName := range NamesFromSchema(typedAttr.Attributes) {
+				names = append(names, name+"."+nestedName)
+			}
+		}
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+// StructAttributes returns the attribute values of a model, keyed by their `tfsdk` tag.
+// Fields of embedded structs are included.
+func StructAttributes(model any) map[string]attr.Value {
+	attributes := map[string]attr.Value{}
+	structAttributes(reflect.ValueOf(model), attributes)
+	return attributes
+}
+
+func structAttributes(value reflect.Value, attributes map[string]attr.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+
+		// Exported fields of unexported embedded structs are still accessible
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			structAttributes(value.Field(i), attributes)
+			continue
+		}
+
+		name := field.Tag.Get("tfsdk")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		if attrValue, ok := value.Field(i).Interface().(attr.Value); ok {
+			attributes[name] = attrValue
+		}
+	}
+}
+
+// lookupAttribute returns the attribute value at a dotted path, ie `settings.is_reachable`.
+func lookupAttribute(attributes map[string]attr.Value, name string) (attr.Value, bool) {
+	first, rest, nested := strings.Cut(name, ".")
+
+	value, ok := attributes[first]
+	if !ok || !nested {
+		return value, ok
+	}
+
+	object, ok := value.(interface {
+		ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics)
+	})
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return nil, false
+	}
+
+	objectValue, diags := object.ToObjectValue(context.Background())
+	if diags.HasError() {
+		return nil, false
+	}
+	return lookupAttribute(objectValue.Attributes(), rest)
+}
@@ -0,0 +1,92 @@
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamesFromSchema(t *testing.T) {
+	names := NamesFromSchema(map[string]schema.Attribute{
+		"name":   schema.StringAttribute{Computed: true},
+		"id":     schema.Int64Attribute{Computed: true},
+		"secret": schema.StringAttribute{Computed: true, Sensitive: true},
+		"tags":   schema.ListAttribute{Computed: true, ElementType: types.StringType},
+		"settings": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"is_reachable": schema.BoolAttribute{Computed: true},
+				"token":        schema.StringAttribute{Computed: true, Sensitive: true},
+			},
+		},
+		"members": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"email": schema.StringAttribute{Computed: true},
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, []string{"id", "name", "settings.is_reachable"}, names)
+}
+
+type embeddedModel struct {
+	Id types.Int64 `tfsdk:"id"`
+}
+
+type structModel struct {
+	embeddedModel
+	Name    types.String `tfsdk:"name"`
+	Members []string     `tfsdk:"members"`
+	ignored types.String
+}
+
+func TestStructAttributes(t *testing.T) {
+	attributes := StructAttributes(structModel{
+		embeddedModel: embeddedModel{Id: types.Int64Value(1)},
+		Name:          types.StringValue("example"),
+		ignored:       types.StringValue("ignored"),
+	})
+
+	assert.Equal(t, map[string]attr.Value{
+		"id":   types.Int64Value(1),
+		"name": types.StringValue("example"),
+	}, attributes)
+}
+
+func TestLookupAttribute(t *testing.T) {
+	settings := types.ObjectValueMust(
+		map[string]attr.Type{"is_reachable": types.BoolType},
+		map[string]attr.Value{"is_reachable": types.BoolValue(true)},
+	)
+	attributes := map[string]attr.Value{
+		"name":     types.StringValue("example"),
+		"settings": settings,
+		"missing":  types.ObjectNull(map[string]attr.Type{"is_reachable": types.BoolType}),
+	}
+
+	value, ok := lookupAttribute(attributes, "name")
+	assert.True(t, ok)
+	assert.Equal(t, types.StringValue("example"), value)
+
+	value, ok = lookupAttribute(attributes, "settings.is_reachable")
+	assert.True(t, ok)
+	assert.Equal(t, types.BoolValue(true), value)
+
+	_, ok = lookupAttribute(attributes, "settings.unknown")
+	assert.False(t, ok)
+
+	_, ok = lookupAttribute(attributes, "missing.is_reachable")
+	assert.False(t, ok)
+
+	_, ok = lookupAttribute(attributes, "name.nested")
+	assert.False(t, ok)
+
+	assert.True(t, Compile(context.Background(), FromLookup(map[string]attr.Value{"settings.is_reachable": types.BoolValue(true)})).OnAttributes(attributes))
+}
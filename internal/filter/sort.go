@@ -0,0 +1,128 @@
+package filter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Sort orders of a list datasource.
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// SortModel holds the sorting and limit arguments of a list datasource.
+type SortModel struct {
+	SortBy     types.String `tfsdk:"sort_by"`
+	Order      types.String `tfsdk:"order"`
+	Limit      types.Int64  `tfsdk:"limit"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+}
+
+// CreateDatasourceSort creates the sorting and limit attributes for a list datasource schema.
+// `most_recent` sorts descending on mostRecentField, ie `created_at`, and keeps the first result.
+func CreateDatasourceSort(allowedFields []string, mostRecentField string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"sort_by": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Name of the field to sort results on. Numbers and RFC3339 times are sorted by value, other values as strings. Results without a value are sorted last. Valid names are `%s`", strings.Join(allowedFields, "`, `")),
+			Validators: []validator.String{
+				stringvalidator.OneOf(allowedFields...),
+			},
+		},
+		"order": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Sort order, `%s` or `%s`. Defaults to `%s`.", OrderAsc, OrderDesc, OrderAsc),
+			Validators: []validator.String{
+				stringvalidator.OneOf(OrderAsc, OrderDesc),
+				stringvalidator.AlsoRequires(path.MatchRoot("sort_by")),
+			},
+		},
+		"limit": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Maximum number of results, applied after filtering and sorting.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"most_recent": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return the most recent result, by `%s`.", mostRecentField),
+			Validators: []validator.Bool{
+				boolvalidator.ConflictsWith(
+					path.MatchRoot("sort_by"),
+					path.MatchRoot("order"),
+					path.MatchRoot("limit"),
+				),
+			},
+		},
+	}
+}
+
+// Sort orders and limits the items as configured in the model.
+// Without `sort_by`, the order of the items is kept.
+func Sort[T any](
+	items []T,
+	attributes func(item T) map[string]attr.Value,
+	model SortModel,
+	mostRecentField string,
+) []T {
+	sortBy, order, limit := model.SortBy.ValueString(), model.Order.ValueString(), model.Limit.ValueInt64()
+	if model.MostRecent.ValueBool() {
+		sortBy, order, limit = mostRecentField, OrderDesc, 1
+	}
+
+	if sortBy != "" {
+		items = slices.Clone(items)
+		slices.SortStableFunc(items, func(a, b T) int {
+			aValue, _ := lookupAttribute(attributes(a), sortBy)
+			bValue, _ := lookupAttribute(attributes(b), sortBy)
+
+			// Values that are not set are always last
+			aMissing := aValue == nil || aValue.IsNull() || aValue.IsUnknown()
+			bMissing := bValue == nil || bValue.IsNull() || bValue.IsUnknown()
+			switch {
+			case aMissing && bMissing:
+				return 0
+			case aMissing:
+				return 1
+			case bMissing:
+				return -1
+			}
+
+			cmp := compareValues(attributeValueToString(aValue), attributeValueToString(bValue))
+			if order == OrderDesc {
+				return -cmp
+			}
+			return cmp
+		})
+	}
+
+	if limit > 0 && int64(len(items)) > limit {
+		items = items[:limit]
+	}
+	return items
+}
+
+// Identity returns the attributes themselves, to sort items that are attribute maps.
+func Identity(attributes map[string]attr.Value) map[string]attr.Value {
+	return attributes
+}
+
+// compareValues compares two values as numbers or RFC3339 times if possible, or else as strings.
+func compareValues(a, b string) int {
+	if cmp, ok := compareOrdered(a, b); ok {
+		return cmp
+	}
+	return strings.Compare(a, b)
+}
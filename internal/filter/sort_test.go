@@ -0,0 +1,73 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	item := func(name string, id int64, createdAt string) map[string]attr.Value {
+		created := types.StringNull()
+		if createdAt != "" {
+			created = types.StringValue(createdAt)
+		}
+		return map[string]attr.Value{
+			"name":       types.StringValue(name),
+			"id":         types.Int64Value(id),
+			"created_at": created,
+		}
+	}
+	items := []map[string]attr.Value{
+		item("b", 9, "2024-03-01T00:00:00Z"),
+		item("c", 10, ""),
+		item("a", 2, "2024-05-01T00:00:00.123456Z"),
+	}
+	names := func(items []map[string]attr.Value) []string {
+		result := []string{}
+		for _, item := range items {
+			result = append(result, attributeValueToString(item["name"]))
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		sort     SortModel
+		expected []string
+	}{
+		{"Unsorted", SortModel{}, []string{"b", "c", "a"}},
+		{"ByString", SortModel{SortBy: types.StringValue("name")}, []string{"a", "b", "c"}},
+		{"ByNumber", SortModel{SortBy: types.StringValue("id")}, []string{"a", "b", "c"}},
+		{"ByNumberDesc", SortModel{SortBy: types.StringValue("id"), Order: types.StringValue(OrderDesc)}, []string{"c", "b", "a"}},
+		{"ByTimeMissingLast", SortModel{SortBy: types.StringValue("created_at")}, []string{"b", "a", "c"}},
+		{"ByTimeDescMissingLast", SortModel{SortBy: types.StringValue("created_at"), Order: types.StringValue(OrderDesc)}, []string{"a", "b", "c"}},
+		{"Limit", SortModel{SortBy: types.StringValue("name"), Limit: types.Int64Value(2)}, []string{"a", "b"}},
+		{"LimitUnsorted", SortModel{Limit: types.Int64Value(1)}, []string{"b"}},
+		{"LimitAboveLength", SortModel{Limit: types.Int64Value(10)}, []string{"b", "c", "a"}},
+		{"MostRecent", SortModel{MostRecent: types.BoolValue(true)}, []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Sort(items, Identity, tt.sort, "created_at")
+			assert.Equal(t, tt.expected, names(result))
+		})
+	}
+
+	// The input is not modified
+	assert.Equal(t, []string{"b", "c", "a"}, names(items))
+}
+
+func TestCreateDatasourceSort(t *testing.T) {
+	attributes := CreateDatasourceSort([]string{"name", "id"}, "created_at")
+
+	for _, name := range []string{"sort_by", "order", "limit", "most_recent"} {
+		assert.Contains(t, attributes, name)
+		assert.True(t, attributes[name].IsOptional(), "%s should be optional", name)
+	}
+	assert.Contains(t, attributes["most_recent"].(schema.BoolAttribute).MarkdownDescription, "`created_at`")
+}
@@ -0,0 +1,444 @@
+// Package generate writes `import` blocks and matching resource configuration for existing Coolify infrastructure.
+package generate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+)
+
+type environment struct {
+	ProjectUuid string
+	Name        string
+}
+
+type generator struct {
+	client *api.ClientWithResponses
+	config *config
+
+	privateKeys      map[int]string      // private key ID -> resource name
+	servers          map[string]string   // server UUID -> resource name
+	serverByResource map[string]string   // resource UUID -> server UUID
+	projects         map[string]string   // project UUID -> resource name
+	environments     map[int]environment // environment ID -> environment
+}
+
+// Run connects to Coolify with the same settings as the provider, and writes the configuration for everything it finds.
+// Secrets are replaced by sensitive variables, their values are only written to the `-var-file` when requested.
+func Run(ctx context.Context, version string, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	endpoint := flags.String("endpoint", envOrDefault(consts.ENV_KEY_ENDPOINT, consts.DEFAULT_COOLIFY_ENDPOINT), "Coolify API endpoint, defaults to $"+consts.ENV_KEY_ENDPOINT)
+	token := flags.String("token", os.Getenv(consts.ENV_KEY_TOKEN), "Coolify API token, defaults to $"+consts.ENV_KEY_TOKEN)
+	out := flags.String("out", "", "File to write the configuration to, defaults to stdout")
+	varFile := flags.String("var-file", "", "`File` to write the secret values to, ie secrets.auto.tfvars. Keep it out of version control")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if *token == "" {
+		return fmt.Errorf("no token provided, set -token or $%s", consts.ENV_KEY_TOKEN)
+	}
+
+	client, err := api.NewAPIClient(version, *endpoint, *token, api.RetryConfig{
+		MaxAttempts: consts.DEFAULT_RETRY_ATTEMPTS,
+		MinWait:     consts.DEFAULT_RETRY_MIN_WAIT,
+		MaxWait:     consts.DEFAULT_RETRY_MAX_WAIT,
+	}, api.LimitConfig{})
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	g := &generator{
+		client:           client,
+		config:           newConfig(),
+		privateKeys:      map[int]string{},
+		servers:          map[string]string{},
+		serverByResource: map[string]string{},
+		projects:         map[string]string{},
+		environments:     map[int]environment{},
+	}
+
+	for _, step := range []func(context.Context) error{
+		g.generatePrivateKeys,
+		g.generateServers,
+		g.generateProjects,
+		g.generateDatabases,
+		g.generateServiceEnvs,
+		g.generateApplicationEnvs,
+	} {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+
+	output := append(g.config.Variables(), g.config.Resources()...)
+	if *out == "" {
+		if _, err := stdout.Write(output); err != nil {
+			return err
+		}
+	} else if err := os.WriteFile(*out, output, 0o644); err != nil {
+		return err
+	}
+
+	if *varFile != "" {
+		if err := os.WriteFile(*varFile, g.config.Tfvars(), 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func envOrDefault(key, fallback string) string {
+	if value, found := os.LookupEnv(key); found {
+		return value
+	}
+	return fallback
+}
+
+func checkResponse(what string, statusCode int, status string, body []byte, decoded bool) error {
+	if statusCode != http.StatusOK || !decoded {
+		return fmt.Errorf("unexpected HTTP status code reading %s: received %s. Details: %s", what, status, body)
+	}
+	return nil
+}
+
+func (g *generator) generatePrivateKeys(ctx context.Context) error {
+	resp, err := g.client.ListPrivateKeysWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading private keys: %w", err)
+	}
+	if err := checkResponse("private keys", resp.StatusCode(), resp.Status(), resp.Body, resp.JSON200 != nil); err != nil {
+		return err
+	}
+
+	for _, key := range *resp.JSON200 {
+		if key.Uuid == nil || key.Name == nil {
+			continue
+		}
+
+		name := g.config.name("coolify_private_key", *key.Name)
+		body := g.config.resource("coolify_private_key", name, *key.Uuid)
+		setString(body, "name", key.Name)
+		setString(body, "description", key.Description)
+		if key.PrivateKey != nil {
+			body.SetAttributeTraversal("private_key", g.config.secret(name+"_private_key", *key.PrivateKey))
+		}
+
+		if key.Id != nil {
+			g.privateKeys[*key.Id] = name
+		}
+	}
+	return nil
+}
+
+func (g *generator) generateServers(ctx context.Context) error {
+	resp, err := g.client.ListServersWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading servers: %w", err)
+	}
+	if err := checkResponse("servers", resp.StatusCode(), resp.Status(), resp.Body, resp.JSON200 != nil); err != nil {
+		return err
+	}
+
+	// The private key of a server is not part of the documented API model
+	var privateKeyIds []struct {
+		Uuid         string `json:"uuid"`
+		PrivateKeyId *int   `json:"private_key_id"`
+	}
+	_ = json.Unmarshal(resp.Body, &privateKeyIds)
+	keyByServer := map[string]int{}
+	for _, sv := range privateKeyIds {
+		if sv.PrivateKeyId != nil {
+			keyByServer[sv.Uuid] = *sv.PrivateKeyId
+		}
+	}
+
+	for _, sv := range *resp.JSON200 {
+		if sv.Uuid == nil || sv.Name == nil {
+			continue
+		}
+
+		name := g.config.name("coolify_server", *sv.Name)
+		body := g.config.resource("coolify_server", name, *sv.Uuid)
+		setString(body, "name", sv.Name)
+		setString(body, "description", sv.Description)
+		setString(body, "ip", sv.Ip)
+		setInt(body, "port", sv.Port)
+		setString(body, "user", sv.User)
+		keyId, hasKey := keyByServer[*sv.Uuid]
+		if keyName, ok := g.privateKeys[keyId]; hasKey && ok {
+			body.SetAttributeTraversal("private_key_uuid", reference("coolify_private_key", keyName, "uuid"))
+		} else {
+			// The private key is required, but Coolify did not report one that was generated
+			if hasKey {
+				todo(body, "set the UUID of the private key of this server, private key id=%d was not found", keyId)
+			} else {
+				todo(body, "set the UUID of the private key of this server, Coolify did not report it")
+			}
+			body.SetAttributeValue("private_key_uuid", cty.StringVal(""))
+		}
+		if sv.ProxyType != nil {
+			body.SetAttributeValue("proxy_type", cty.StringVal(string(*sv.ProxyType)))
+		}
+		body.SetAttributeValue("instant_validate", cty.False)
+
+		g.servers[*sv.Uuid] = name
+
+		resourcesResp, err := g.client.GetResourcesByServerUuidWithResponse(ctx, *sv.Uuid)
+		if err != nil {
+			return fmt.Errorf("error reading server resources: uuid=%s: %w", *sv.Uuid, err)
+		}
+		if err := checkResponse("server resources", resourcesResp.StatusCode(), resourcesResp.Status(), resourcesResp.Body, resourcesResp.JSON200 != nil); err != nil {
+			return err
+		}
+		for _, res := range *resourcesResp.JSON200 {
+			if res.Uuid != nil {
+				g.serverByResource[*res.Uuid] = *sv.Uuid
+			}
+		}
+	}
+	return nil
+}
+
+func (g *generator) generateProjects(ctx context.Context) error {
+	resp, err := g.client.ListProjectsWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading projects: %w", err)
+	}
+	if err := checkResponse("projects", resp.StatusCode(), resp.Status(), resp.Body, resp.JSON200 != nil); err != nil {
+		return err
+	}
+
+	for _, project := range *resp.JSON200 {
+		if project.Uuid == nil || project.Name == nil {
+			continue
+		}
+
+		name := g.config.name("coolify_project", *project.Name)
+		body := g.config.resource("coolify_project", name, *project.Uuid)
+		setString(body, "name", project.Name)
+		setString(body, "description", project.Description)
+		g.projects[*project.Uuid] = name
+
+		// todo: Coolify API bug, environments are not returned when listing projects
+		projectResp, err := g.client.GetProjectByUuidWithResponse(ctx, *project.Uuid)
+		if err != nil {
+			return fmt.Errorf("error reading project: uuid=%s: %w", *project.Uuid, err)
+		}
+		if err := checkResponse("project", projectResp.StatusCode(), projectResp.Status(), projectResp.Body, projectResp.JSON200 != nil); err != nil {
+			return err
+		}
+		if projectResp.JSON200.Environments == nil {
+			continue
+		}
+
+		var names []string
+		for _, env := range *projectResp.JSON200.Environments {
+			if env.Id == nil || env.Name == nil {
+				continue
+			}
+			g.environments[*env.Id] = environment{ProjectUuid: *project.Uuid, Name: *env.Name}
+			names = append(names, *env.Name)
+		}
+		if len(names) > 0 {
+			g.config.comment("Environments of project %q can not be managed yet: %v", *project.Name, names)
+		}
+	}
+	return nil
+}
+
+func (g *generator) generateDatabases(ctx context.Context) error {
+	resp, err := g.client.ListDatabasesWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading databases: %w", err)
+	}
+	if err := checkResponse("databases", resp.StatusCode(), resp.Status(), resp.Body, resp.JSON200 != nil); err != nil {
+		return err
+	}
+
+	for _, db := range *resp.JSON200 {
+		common, err := db.AsDatabaseCommon()
+		if err != nil {
+			return fmt.Errorf("error decoding database: %w", err)
+		}
+		dbName := common.Uuid
+		if common.Name != nil {
+			dbName = *common.Name
+		}
+
+		// The environment of a database is not part of the documented API model
+		raw, _ := db.MarshalJSON()
+		var location struct {
+			EnvironmentId *int `json:"environment_id"`
+		}
+		_ = json.Unmarshal(raw, &location)
+
+		serverUuid, hasServer := g.serverByResource[common.Uuid]
+		var env environment
+		hasEnv := false
+		if location.EnvironmentId != nil {
+			env, hasEnv = g.environments[*location.EnvironmentId]
+		}
+
+		var resourceType string
+		switch common.DatabaseType {
+		case "standalone-postgresql":
+			resourceType = "coolify_postgresql_database"
+		case "standalone-mysql":
+			resourceType = "coolify_mysql_database"
+		default:
+			g.config.comment("Database %q (%s, uuid=%s) can not be managed yet", dbName, common.DatabaseType, common.Uuid)
+			continue
+		}
+
+		if !hasServer || !hasEnv {
+			g.config.comment("Database %q (uuid=%s) skipped, its server or environment could not be found", dbName, common.Uuid)
+			continue
+		}
+
+		name := g.config.name(resourceType, dbName)
+		body := g.config.resource(resourceType, name, fmt.Sprintf("%s/%s/%s/%s", serverUuid, env.ProjectUuid, env.Name, common.Uuid))
+		setString(body, "name", common.Name)
+		setString(body, "description", common.Description)
+		body.AppendNewline()
+		body.SetAttributeTraversal("server_uuid", reference("coolify_server", g.servers[serverUuid], "uuid"))
+		body.SetAttributeTraversal("project_uuid", reference("coolify_project", g.projects[env.ProjectUuid], "uuid"))
+		body.SetAttributeValue("environment_name", cty.StringVal(env.Name))
+		body.AppendNewline()
+		setString(body, "image", common.Image)
+		setBool(body, "is_public", common.IsPublic)
+		setInt(body, "public_port", common.PublicPort)
+
+		switch common.DatabaseType {
+		case "standalone-postgresql":
+			pg, err := db.AsPostgresqlDatabase()
+			if err != nil {
+				return fmt.Errorf("error decoding database: %w", err)
+			}
+			setString(body, "postgres_db", pg.PostgresDb)
+			setString(body, "postgres_user", pg.PostgresUser)
+			if pg.PostgresPassword != nil {
+				body.SetAttributeTraversal("postgres_password", g.config.secret(name+"_postgres_password", *pg.PostgresPassword))
+			}
+			setString(body, "postgres_conf", pg.PostgresConf)
+			setString(body, "postgres_host_auth_method", pg.PostgresHostAuthMethod)
+			setString(body, "postgres_initdb_args", pg.PostgresInitdbArgs)
+		case "standalone-mysql":
+			my, err := db.AsMysqlDatabase()
+			if err != nil {
+				return fmt.Errorf("error decoding database: %w", err)
+			}
+			setString(body, "mysql_database", my.MysqlDatabase)
+			setString(body, "mysql_user", my.MysqlUser)
+			if my.MysqlPassword != nil {
+				body.SetAttributeTraversal("mysql_password", g.config.secret(name+"_mysql_password", *my.MysqlPassword))
+			}
+			if my.MysqlRootPassword != nil {
+				body.SetAttributeTraversal("mysql_root_password", g.config.secret(name+"_mysql_root_password", *my.MysqlRootPassword))
+			}
+			setString(body, "mysql_conf", my.MysqlConf)
+		}
+	}
+	return nil
+}
+
+func (g *generator) generateServiceEnvs(ctx context.Context) error {
+	resp, err := g.client.ListServicesWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading services: %w", err)
+	}
+	if err := checkResponse("services", resp.StatusCode(), resp.Status(), resp.Body, resp.JSON200 != nil); err != nil {
+		return err
+	}
+
+	for _, service := range *resp.JSON200 {
+		if service.Uuid == nil || service.Name == nil {
+			continue
+		}
+
+		envsResp, err := g.client.ListEnvsByServiceUuidWithResponse(ctx, *service.Uuid)
+		if err != nil {
+			return fmt.Errorf("error reading service envs: uuid=%s: %w", *service.Uuid, err)
+		}
+		if err := checkResponse("service envs", envsResp.StatusCode(), envsResp.Status(), envsResp.Body, envsResp.JSON200 != nil); err != nil {
+			return err
+		}
+
+		g.config.comment("Service %q (uuid=%s) can not be managed yet, only its environment variables", *service.Name, *service.Uuid)
+		g.envs("coolify_service_envs", *service.Name, *service.Uuid, *envsResp.JSON200)
+	}
+	return nil
+}
+
+func (g *generator) generateApplicationEnvs(ctx context.Context) error {
+	resp, err := g.client.ListApplicationsWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading applications: %w", err)
+	}
+	if err := checkResponse("applications", resp.StatusCode(), resp.Status(), resp.Body, resp.JSON200 != nil); err != nil {
+		return err
+	}
+
+	for _, app := range *resp.JSON200 {
+		if app.Uuid == nil || app.Name == nil {
+			continue
+		}
+
+		envsResp, err := g.client.ListEnvsByApplicationUuidWithResponse(ctx, *app.Uuid)
+		if err != nil {
+			return fmt.Errorf("error reading application envs: uuid=%s: %w", *app.Uuid, err)
+		}
+		if err := checkResponse("application envs", envsResp.StatusCode(), envsResp.Status(), envsResp.Body, envsResp.JSON200 != nil); err != nil {
+			return err
+		}
+
+		g.config.comment("Application %q (uuid=%s) can not be managed yet, only its environment variables", *app.Name, *app.Uuid)
+		g.envs("coolify_application_envs", *app.Name, *app.Uuid, *envsResp.JSON200)
+	}
+	return nil
+}
+
+// envs adds an environment variables resource, with every value replaced by a variable.
+func (g *generator) envs(resourceType, resourceName, uuid string, envs []api.EnvironmentVariable) {
+	if len(envs) == 0 {
+		return
+	}
+
+	name := g.config.name(resourceType, resourceName)
+	body := g.config.resource(resourceType, name, uuid)
+	body.SetAttributeValue("uuid", cty.StringVal(uuid))
+	body.AppendNewline()
+
+	for _, env := range envs {
+		if env.Key == nil {
+			continue
+		}
+
+		block := body.AppendNewBlock("env", nil).Body()
+		block.SetAttributeValue("key", cty.StringVal(*env.Key))
+		value := ""
+		if env.Value != nil {
+			value = *env.Value
+		}
+		block.SetAttributeTraversal("value", g.config.secret(name+"_"+*env.Key, value))
+		if env.IsPreview != nil && *env.IsPreview {
+			setBool(block, "is_preview", env.IsPreview)
+		}
+		setBool(block, "is_build_time", env.IsBuildTime)
+		setBool(block, "is_literal", env.IsLiteral)
+	}
+}
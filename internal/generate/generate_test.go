@@ -0,0 +1,121 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mockToken = "1|generate"
+
+var mockResponses = map[string]string{
+	"/security/keys": `[{"id":1,"uuid":"key-uuid","name":"Deploy Key","private_key":"-----BEGIN KEY-----secret"}]`,
+	"/servers": `[
+		{"uuid":"server-uuid","name":"My Server","ip":"10.0.0.1","port":22,"user":"root","proxy_type":"traefik","private_key_id":1},
+		{"uuid":"keyless-uuid","name":"Keyless","ip":"10.0.0.2"}
+	]`,
+	"/servers/keyless-uuid/resources": `[]`,
+	"/servers/server-uuid/resources": `[
+		{"uuid":"pg-uuid","type":"standalone-postgresql"},
+		{"uuid":"redis-uuid","type":"standalone-redis"}
+	]`,
+	"/projects":              `[{"uuid":"project-uuid","name":"Website"}]`,
+	"/projects/project-uuid": `{"uuid":"project-uuid","name":"Website","environments":[{"id":7,"name":"production"}]}`,
+	"/databases": `[
+		{"uuid":"pg-uuid","name":"Main DB","database_type":"standalone-postgresql","environment_id":7,"postgres_user":"app","postgres_password":"pg-secret"},
+		{"uuid":"redis-uuid","name":"cache","database_type":"standalone-redis","environment_id":7,"redis_password":"redis-secret"}
+	]`,
+	"/services":                   `[{"uuid":"service-uuid","name":"plausible"}]`,
+	"/services/service-uuid/envs": `[{"key":"SECRET_KEY_BASE","value":"service-secret","is_build_time":false,"is_literal":true}]`,
+	"/applications":               `[{"uuid":"app-uuid","name":"empty"}]`,
+	"/applications/app-uuid/envs": `[]`,
+}
+
+func mockHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+mockToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	body, ok := mockResponses[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
+
+func TestRun(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(mockHandler))
+	defer mockServer.Close()
+
+	varFile := filepath.Join(t.TempDir(), "secrets.auto.tfvars")
+
+	var out bytes.Buffer
+	err := Run(context.Background(), "test", []string{
+		"-endpoint", mockServer.URL,
+		"-token", mockToken,
+		"-var-file", varFile,
+	}, &out)
+	require.NoError(t, err)
+	output := out.String()
+
+	for _, expected := range []string{
+		`variable "deploy_key_private_key" {`,
+		`to = coolify_private_key.deploy_key`,
+		`private_key = var.deploy_key_private_key`,
+		`to = coolify_server.my_server`,
+		`private_key_uuid = coolify_private_key.deploy_key.uuid`,
+		"  # TODO: set the UUID of the private key of this server, Coolify did not report it\n  private_key_uuid = \"\"",
+		`to = coolify_project.website`,
+		`# Environments of project "Website" can not be managed yet: [production]`,
+		`id = "server-uuid/project-uuid/production/pg-uuid"`,
+		`server_uuid      = coolify_server.my_server.uuid`,
+		`postgres_password = var.main_db_postgres_password`,
+		`# Database "cache" (standalone-redis, uuid=redis-uuid) can not be managed yet`,
+		`to = coolify_service_envs.plausible`,
+		`value         = var.plausible_secret_key_base`,
+	} {
+		assert.Contains(t, output, expected)
+	}
+
+	assert.NotContains(t, output, "coolify_application_envs", "applications without envs should be skipped")
+	for _, secret := range []string{"-----BEGIN KEY-----secret", "pg-secret", "redis-secret", "service-secret"} {
+		assert.NotContains(t, output, secret, "secrets should be replaced by variables")
+	}
+
+	tfvars, err := os.ReadFile(varFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(tfvars), `main_db_postgres_password`)
+	assert.Contains(t, string(tfvars), `"pg-secret"`)
+	assert.Contains(t, string(tfvars), `"service-secret"`)
+}
+
+func TestRunErrors(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(mockHandler))
+	defer mockServer.Close()
+
+	t.Setenv("COOLIFY_TOKEN", "")
+	err := Run(context.Background(), "test", []string{"-endpoint", mockServer.URL}, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "no token provided")
+
+	err = Run(context.Background(), "test", []string{"-endpoint", mockServer.URL, "-token", "2|unauthorized"}, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "unexpected HTTP status code reading private keys")
+}
+
+func TestConfigName(t *testing.T) {
+	c := newConfig()
+	assert.Equal(t, "my_server", c.name("coolify_server", "My Server!"))
+	assert.Equal(t, "my_server_2", c.name("coolify_server", "my-server"))
+	assert.Equal(t, "my_server", c.name("coolify_project", "My Server"))
+	assert.Equal(t, "r_1st", c.name("coolify_project", "1st"))
+	assert.Equal(t, "r_", c.name("coolify_project", "***"))
+}
@@ -0,0 +1,150 @@
+package generate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// config collects the generated blocks, and the variables replacing secrets.
+type config struct {
+	resources *hclwrite.File
+	names     map[string]bool
+	secrets   map[string]string
+}
+
+func newConfig() *config {
+	return &config{
+		resources: hclwrite.NewEmptyFile(),
+		names:     map[string]bool{},
+		secrets:   map[string]string{},
+	}
+}
+
+// name returns a unique identifier derived from a human readable name, ie `My Server` -> `my_server`.
+func (c *config) name(scope, name string) string {
+	base := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "r_" + base
+	}
+
+	unique := base
+	for i := 2; c.names[scope+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", base, i)
+	}
+	c.names[scope+"."+unique] = true
+	return unique
+}
+
+// comment adds a comment line to the generated configuration.
+func (c *config) comment(format string, args ...any) {
+	c.resources.Body().AppendNewline()
+	c.resources.Body().AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# " + fmt.Sprintf(format, args...) + "\n")},
+	})
+}
+
+// todo adds a comment line to a block, for a value that has to be set by hand.
+func todo(body *hclwrite.Body, format string, args ...any) {
+	body.AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# TODO: " + fmt.Sprintf(format, args...) + "\n")},
+	})
+}
+
+// resource adds an import block and the matching resource block, returning the body of the resource.
+func (c *config) resource(resourceType, name, id string) *hclwrite.Body {
+	body := c.resources.Body()
+	body.AppendNewline()
+
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(id))
+
+	body.AppendNewline()
+	return body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+// secret replaces a secret value by a sensitive variable and returns a reference to it.
+func (c *config) secret(name, value string) hcl.Traversal {
+	name = c.name("var", name)
+	c.secrets[name] = value
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	}
+}
+
+// reference returns a reference to an attribute of another generated resource.
+func reference(resourceType, name, attribute string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+		hcl.TraverseAttr{Name: attribute},
+	}
+}
+
+func setString(body *hclwrite.Body, name string, value *string) {
+	if value != nil && *value != "" {
+		body.SetAttributeValue(name, cty.StringVal(*value))
+	}
+}
+
+func setBool(body *hclwrite.Body, name string, value *bool) {
+	if value != nil {
+		body.SetAttributeValue(name, cty.BoolVal(*value))
+	}
+}
+
+func setInt(body *hclwrite.Body, name string, value *int) {
+	if value != nil {
+		body.SetAttributeValue(name, cty.NumberIntVal(int64(*value)))
+	}
+}
+
+func (c *config) secretNames() []string {
+	names := make([]string, 0, len(c.secrets))
+	for name := range c.secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Variables returns the sensitive variable declarations for the secrets.
+func (c *config) Variables() []byte {
+	file := hclwrite.NewEmptyFile()
+	for i, name := range c.secretNames() {
+		if i > 0 {
+			file.Body().AppendNewline()
+		}
+		body := file.Body().AppendNewBlock("variable", []string{name}).Body()
+		body.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		body.SetAttributeValue("sensitive", cty.True)
+	}
+	return file.Bytes()
+}
+
+// Tfvars returns the values of the secrets, to be kept out of version control.
+func (c *config) Tfvars() []byte {
+	file := hclwrite.NewEmptyFile()
+	for _, name := range c.secretNames() {
+		file.Body().SetAttributeValue(name, cty.StringVal(c.secrets[name]))
+	}
+	return file.Bytes()
+}
+
+// Resources returns the import and resource blocks.
+func (c *config) Resources() []byte {
+	return hclwrite.Format(c.resources.Bytes())
+}
@@ -0,0 +1,1388 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package datasource_services
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func ServicesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"services": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"config_hash": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The hash of the service configuration.",
+							MarkdownDescription: "The hash of the service configuration.",
+						},
+						"connect_to_docker_network": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "The flag to connect the service to the predefined Docker network.",
+							MarkdownDescription: "The flag to connect the service to the predefined Docker network.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The date and time when the service was created.",
+							MarkdownDescription: "The date and time when the service was created.",
+						},
+						"deleted_at": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The date and time when the service was deleted.",
+							MarkdownDescription: "The date and time when the service was deleted.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The description of the service.",
+							MarkdownDescription: "The description of the service.",
+						},
+						"destination_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The unique identifier of the destination where the service is running.",
+							MarkdownDescription: "The unique identifier of the destination where the service is running.",
+						},
+						"destination_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Destination type.",
+							MarkdownDescription: "Destination type.",
+						},
+						"docker_compose": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The docker-compose.yml file that is parsed and modified by Coolify.",
+							MarkdownDescription: "The docker-compose.yml file that is parsed and modified by Coolify.",
+						},
+						"docker_compose_raw": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The raw docker-compose.yml file of the service.",
+							MarkdownDescription: "The raw docker-compose.yml file of the service.",
+						},
+						"environment_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The unique identifier of the environment where the service is attached to.",
+							MarkdownDescription: "The unique identifier of the environment where the service is attached to.",
+						},
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The unique identifier of the service. Only used for database identification.",
+							MarkdownDescription: "The unique identifier of the service. Only used for database identification.",
+						},
+						"is_container_label_escape_enabled": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "The flag to enable the container label escape.",
+							MarkdownDescription: "The flag to enable the container label escape.",
+						},
+						"is_container_label_readonly_enabled": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "The flag to enable the container label readonly.",
+							MarkdownDescription: "The flag to enable the container label readonly.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the service.",
+							MarkdownDescription: "The name of the service.",
+						},
+						"server_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The unique identifier of the server where the service is running.",
+							MarkdownDescription: "The unique identifier of the server where the service is running.",
+						},
+						"service_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The type of the service.",
+							MarkdownDescription: "The type of the service.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The date and time when the service was last updated.",
+							MarkdownDescription: "The date and time when the service was last updated.",
+						},
+						"uuid": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The unique identifier of the service.",
+							MarkdownDescription: "The unique identifier of the service.",
+						},
+					},
+					CustomType: ServicesType{
+						ObjectType: types.ObjectType{
+							AttrTypes: ServicesValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+type ServicesModel struct {
+	Services types.Set `tfsdk:"services"`
+}
+
+var _ basetypes.ObjectTypable = ServicesType{}
+
+type ServicesType struct {
+	basetypes.ObjectType
+}
+
+func (t ServicesType) Equal(o attr.Type) bool {
+	other, ok := o.(ServicesType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ServicesType) String() string {
+	return "ServicesType"
+}
+
+func (t ServicesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	configHashAttribute, ok := attributes["config_hash"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config_hash is missing from object`)
+
+		return nil, diags
+	}
+
+	configHashVal, ok := configHashAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config_hash expected to be basetypes.StringValue, was: %T`, configHashAttribute))
+	}
+
+	connectToDockerNetworkAttribute, ok := attributes["connect_to_docker_network"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`connect_to_docker_network is missing from object`)
+
+		return nil, diags
+	}
+
+	connectToDockerNetworkVal, ok := connectToDockerNetworkAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`connect_to_docker_network expected to be basetypes.BoolValue, was: %T`, connectToDockerNetworkAttribute))
+	}
+
+	createdAtAttribute, ok := attributes["created_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`created_at is missing from object`)
+
+		return nil, diags
+	}
+
+	createdAtVal, ok := createdAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`created_at expected to be basetypes.StringValue, was: %T`, createdAtAttribute))
+	}
+
+	deletedAtAttribute, ok := attributes["deleted_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`deleted_at is missing from object`)
+
+		return nil, diags
+	}
+
+	deletedAtVal, ok := deletedAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`deleted_at expected to be basetypes.StringValue, was: %T`, deletedAtAttribute))
+	}
+
+	descriptionAttribute, ok := attributes["description"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`description is missing from object`)
+
+		return nil, diags
+	}
+
+	descriptionVal, ok := descriptionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`description expected to be basetypes.StringValue, was: %T`, descriptionAttribute))
+	}
+
+	destinationIdAttribute, ok := attributes["destination_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`destination_id is missing from object`)
+
+		return nil, diags
+	}
+
+	destinationIdVal, ok := destinationIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`destination_id expected to be basetypes.Int64Value, was: %T`, destinationIdAttribute))
+	}
+
+	destinationTypeAttribute, ok := attributes["destination_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`destination_type is missing from object`)
+
+		return nil, diags
+	}
+
+	destinationTypeVal, ok := destinationTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`destination_type expected to be basetypes.StringValue, was: %T`, destinationTypeAttribute))
+	}
+
+	dockerComposeAttribute, ok := attributes["docker_compose"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`docker_compose is missing from object`)
+
+		return nil, diags
+	}
+
+	dockerComposeVal, ok := dockerComposeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`docker_compose expected to be basetypes.StringValue, was: %T`, dockerComposeAttribute))
+	}
+
+	dockerComposeRawAttribute, ok := attributes["docker_compose_raw"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`docker_compose_raw is missing from object`)
+
+		return nil, diags
+	}
+
+	dockerComposeRawVal, ok := dockerComposeRawAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`docker_compose_raw expected to be basetypes.StringValue, was: %T`, dockerComposeRawAttribute))
+	}
+
+	environmentIdAttribute, ok := attributes["environment_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`environment_id is missing from object`)
+
+		return nil, diags
+	}
+
+	environmentIdVal, ok := environmentIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`environment_id expected to be basetypes.Int64Value, was: %T`, environmentIdAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return nil, diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.Int64Value, was: %T`, idAttribute))
+	}
+
+	isContainerLabelEscapeEnabledAttribute, ok := attributes["is_container_label_escape_enabled"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`is_container_label_escape_enabled is missing from object`)
+
+		return nil, diags
+	}
+
+	isContainerLabelEscapeEnabledVal, ok := isContainerLabelEscapeEnabledAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`is_container_label_escape_enabled expected to be basetypes.BoolValue, was: %T`, isContainerLabelEscapeEnabledAttribute))
+	}
+
+	isContainerLabelReadonlyEnabledAttribute, ok := attributes["is_container_label_readonly_enabled"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`is_container_label_readonly_enabled is missing from object`)
+
+		return nil, diags
+	}
+
+	isContainerLabelReadonlyEnabledVal, ok := isContainerLabelReadonlyEnabledAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`is_container_label_readonly_enabled expected to be basetypes.BoolValue, was: %T`, isContainerLabelReadonlyEnabledAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	serverIdAttribute, ok := attributes["server_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`server_id is missing from object`)
+
+		return nil, diags
+	}
+
+	serverIdVal, ok := serverIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`server_id expected to be basetypes.Int64Value, was: %T`, serverIdAttribute))
+	}
+
+	serviceTypeAttribute, ok := attributes["service_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`service_type is missing from object`)
+
+		return nil, diags
+	}
+
+	serviceTypeVal, ok := serviceTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`service_type expected to be basetypes.StringValue, was: %T`, serviceTypeAttribute))
+	}
+
+	updatedAtAttribute, ok := attributes["updated_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`updated_at is missing from object`)
+
+		return nil, diags
+	}
+
+	updatedAtVal, ok := updatedAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`updated_at expected to be basetypes.StringValue, was: %T`, updatedAtAttribute))
+	}
+
+	uuidAttribute, ok := attributes["uuid"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`uuid is missing from object`)
+
+		return nil, diags
+	}
+
+	uuidVal, ok := uuidAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`uuid expected to be basetypes.StringValue, was: %T`, uuidAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ServicesValue{
+		ConfigHash:                      configHashVal,
+		ConnectToDockerNetwork:          connectToDockerNetworkVal,
+		CreatedAt:                       createdAtVal,
+		DeletedAt:                       deletedAtVal,
+		Description:                     descriptionVal,
+		DestinationId:                   destinationIdVal,
+		DestinationType:                 destinationTypeVal,
+		DockerCompose:                   dockerComposeVal,
+		DockerComposeRaw:                dockerComposeRawVal,
+		EnvironmentId:                   environmentIdVal,
+		Id:                              idVal,
+		IsContainerLabelEscapeEnabled:   isContainerLabelEscapeEnabledVal,
+		IsContainerLabelReadonlyEnabled: isContainerLabelReadonlyEnabledVal,
+		Name:                            nameVal,
+		ServerId:                        serverIdVal,
+		ServiceType:                     serviceTypeVal,
+		UpdatedAt:                       updatedAtVal,
+		Uuid:                            uuidVal,
+		state:                           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewServicesValueNull() ServicesValue {
+	return ServicesValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewServicesValueUnknown() ServicesValue {
+	return ServicesValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewServicesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ServicesValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ServicesValue Attribute Value",
+				"While creating a ServicesValue value, a missing attribute value was detected. "+
+					"A ServicesValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ServicesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ServicesValue Attribute Type",
+				"While creating a ServicesValue value, an invalid attribute value was detected. "+
+					"A ServicesValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ServicesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ServicesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ServicesValue Attribute Value",
+				"While creating a ServicesValue value, an extra attribute value was detected. "+
+					"A ServicesValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ServicesValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewServicesValueUnknown(), diags
+	}
+
+	configHashAttribute, ok := attributes["config_hash"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config_hash is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	configHashVal, ok := configHashAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config_hash expected to be basetypes.StringValue, was: %T`, configHashAttribute))
+	}
+
+	connectToDockerNetworkAttribute, ok := attributes["connect_to_docker_network"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`connect_to_docker_network is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	connectToDockerNetworkVal, ok := connectToDockerNetworkAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`connect_to_docker_network expected to be basetypes.BoolValue, was: %T`, connectToDockerNetworkAttribute))
+	}
+
+	createdAtAttribute, ok := attributes["created_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`created_at is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	createdAtVal, ok := createdAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`created_at expected to be basetypes.StringValue, was: %T`, createdAtAttribute))
+	}
+
+	deletedAtAttribute, ok := attributes["deleted_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`deleted_at is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	deletedAtVal, ok := deletedAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`deleted_at expected to be basetypes.StringValue, was: %T`, deletedAtAttribute))
+	}
+
+	descriptionAttribute, ok := attributes["description"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`description is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	descriptionVal, ok := descriptionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`description expected to be basetypes.StringValue, was: %T`, descriptionAttribute))
+	}
+
+	destinationIdAttribute, ok := attributes["destination_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`destination_id is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	destinationIdVal, ok := destinationIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`destination_id expected to be basetypes.Int64Value, was: %T`, destinationIdAttribute))
+	}
+
+	destinationTypeAttribute, ok := attributes["destination_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`destination_type is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	destinationTypeVal, ok := destinationTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`destination_type expected to be basetypes.StringValue, was: %T`, destinationTypeAttribute))
+	}
+
+	dockerComposeAttribute, ok := attributes["docker_compose"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`docker_compose is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	dockerComposeVal, ok := dockerComposeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`docker_compose expected to be basetypes.StringValue, was: %T`, dockerComposeAttribute))
+	}
+
+	dockerComposeRawAttribute, ok := attributes["docker_compose_raw"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`docker_compose_raw is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	dockerComposeRawVal, ok := dockerComposeRawAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`docker_compose_raw expected to be basetypes.StringValue, was: %T`, dockerComposeRawAttribute))
+	}
+
+	environmentIdAttribute, ok := attributes["environment_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`environment_id is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	environmentIdVal, ok := environmentIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`environment_id expected to be basetypes.Int64Value, was: %T`, environmentIdAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.Int64Value, was: %T`, idAttribute))
+	}
+
+	isContainerLabelEscapeEnabledAttribute, ok := attributes["is_container_label_escape_enabled"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`is_container_label_escape_enabled is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	isContainerLabelEscapeEnabledVal, ok := isContainerLabelEscapeEnabledAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`is_container_label_escape_enabled expected to be basetypes.BoolValue, was: %T`, isContainerLabelEscapeEnabledAttribute))
+	}
+
+	isContainerLabelReadonlyEnabledAttribute, ok := attributes["is_container_label_readonly_enabled"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`is_container_label_readonly_enabled is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	isContainerLabelReadonlyEnabledVal, ok := isContainerLabelReadonlyEnabledAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`is_container_label_readonly_enabled expected to be basetypes.BoolValue, was: %T`, isContainerLabelReadonlyEnabledAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	serverIdAttribute, ok := attributes["server_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`server_id is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	serverIdVal, ok := serverIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`server_id expected to be basetypes.Int64Value, was: %T`, serverIdAttribute))
+	}
+
+	serviceTypeAttribute, ok := attributes["service_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`service_type is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	serviceTypeVal, ok := serviceTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`service_type expected to be basetypes.StringValue, was: %T`, serviceTypeAttribute))
+	}
+
+	updatedAtAttribute, ok := attributes["updated_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`updated_at is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	updatedAtVal, ok := updatedAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`updated_at expected to be basetypes.StringValue, was: %T`, updatedAtAttribute))
+	}
+
+	uuidAttribute, ok := attributes["uuid"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`uuid is missing from object`)
+
+		return NewServicesValueUnknown(), diags
+	}
+
+	uuidVal, ok := uuidAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`uuid expected to be basetypes.StringValue, was: %T`, uuidAttribute))
+	}
+
+	if diags.HasError() {
+		return NewServicesValueUnknown(), diags
+	}
+
+	return ServicesValue{
+		ConfigHash:                      configHashVal,
+		ConnectToDockerNetwork:          connectToDockerNetworkVal,
+		CreatedAt:                       createdAtVal,
+		DeletedAt:                       deletedAtVal,
+		Description:                     descriptionVal,
+		DestinationId:                   destinationIdVal,
+		DestinationType:                 destinationTypeVal,
+		DockerCompose:                   dockerComposeVal,
+		DockerComposeRaw:                dockerComposeRawVal,
+		EnvironmentId:                   environmentIdVal,
+		Id:                              idVal,
+		IsContainerLabelEscapeEnabled:   isContainerLabelEscapeEnabledVal,
+		IsContainerLabelReadonlyEnabled: isContainerLabelReadonlyEnabledVal,
+		Name:                            nameVal,
+		ServerId:                        serverIdVal,
+		ServiceType:                     serviceTypeVal,
+		UpdatedAt:                       updatedAtVal,
+		Uuid:                            uuidVal,
+		state:                           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewServicesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ServicesValue {
+	object, diags := NewServicesValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewServicesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ServicesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewServicesValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewServicesValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewServicesValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewServicesValueMust(ServicesValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ServicesType) ValueType(ctx context.Context) attr.Value {
+	return ServicesValue{}
+}
+
+var _ basetypes.ObjectValuable = ServicesValue{}
+
+type ServicesValue struct {
+	ConfigHash                      basetypes.StringValue `tfsdk:"config_hash"`
+	ConnectToDockerNetwork          basetypes.BoolValue   `tfsdk:"connect_to_docker_network"`
+	CreatedAt                       basetypes.StringValue `tfsdk:"created_at"`
+	DeletedAt                       basetypes.StringValue `tfsdk:"deleted_at"`
+	Description                     basetypes.StringValue `tfsdk:"description"`
+	DestinationId                   basetypes.Int64Value  `tfsdk:"destination_id"`
+	DestinationType                 basetypes.StringValue `tfsdk:"destination_type"`
+	DockerCompose                   basetypes.StringValue `tfsdk:"docker_compose"`
+	DockerComposeRaw                basetypes.StringValue `tfsdk:"docker_compose_raw"`
+	EnvironmentId                   basetypes.Int64Value  `tfsdk:"environment_id"`
+	Id                              basetypes.Int64Value  `tfsdk:"id"`
+	IsContainerLabelEscapeEnabled   basetypes.BoolValue   `tfsdk:"is_container_label_escape_enabled"`
+	IsContainerLabelReadonlyEnabled basetypes.BoolValue   `tfsdk:"is_container_label_readonly_enabled"`
+	Name                            basetypes.StringValue `tfsdk:"name"`
+	ServerId                        basetypes.Int64Value  `tfsdk:"server_id"`
+	ServiceType                     basetypes.StringValue `tfsdk:"service_type"`
+	UpdatedAt                       basetypes.StringValue `tfsdk:"updated_at"`
+	Uuid                            basetypes.StringValue `tfsdk:"uuid"`
+	state                           attr.ValueState
+}
+
+func (v ServicesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 18)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["config_hash"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["connect_to_docker_network"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["created_at"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["deleted_at"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["description"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["destination_id"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["destination_type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["docker_compose"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["docker_compose_raw"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["environment_id"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["id"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["is_container_label_escape_enabled"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["is_container_label_readonly_enabled"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["server_id"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["service_type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["updated_at"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["uuid"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 18)
+
+		val, err = v.ConfigHash.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["config_hash"] = val
+
+		val, err = v.ConnectToDockerNetwork.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["connect_to_docker_network"] = val
+
+		val, err = v.CreatedAt.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["created_at"] = val
+
+		val, err = v.DeletedAt.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["deleted_at"] = val
+
+		val, err = v.Description.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["description"] = val
+
+		val, err = v.DestinationId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["destination_id"] = val
+
+		val, err = v.DestinationType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["destination_type"] = val
+
+		val, err = v.DockerCompose.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["docker_compose"] = val
+
+		val, err = v.DockerComposeRaw.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["docker_compose_raw"] = val
+
+		val, err = v.EnvironmentId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["environment_id"] = val
+
+		val, err = v.Id.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id"] = val
+
+		val, err = v.IsContainerLabelEscapeEnabled.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["is_container_label_escape_enabled"] = val
+
+		val, err = v.IsContainerLabelReadonlyEnabled.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["is_container_label_readonly_enabled"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.ServerId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["server_id"] = val
+
+		val, err = v.ServiceType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["service_type"] = val
+
+		val, err = v.UpdatedAt.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["updated_at"] = val
+
+		val, err = v.Uuid.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["uuid"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ServicesValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ServicesValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ServicesValue) String() string {
+	return "ServicesValue"
+}
+
+func (v ServicesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"config_hash":                         basetypes.StringType{},
+		"connect_to_docker_network":           basetypes.BoolType{},
+		"created_at":                          basetypes.StringType{},
+		"deleted_at":                          basetypes.StringType{},
+		"description":                         basetypes.StringType{},
+		"destination_id":                      basetypes.Int64Type{},
+		"destination_type":                    basetypes.StringType{},
+		"docker_compose":                      basetypes.StringType{},
+		"docker_compose_raw":                  basetypes.StringType{},
+		"environment_id":                      basetypes.Int64Type{},
+		"id":                                  basetypes.Int64Type{},
+		"is_container_label_escape_enabled":   basetypes.BoolType{},
+		"is_container_label_readonly_enabled": basetypes.BoolType{},
+		"name":                                basetypes.StringType{},
+		"server_id":                           basetypes.Int64Type{},
+		"service_type":                        basetypes.StringType{},
+		"updated_at":                          basetypes.StringType{},
+		"uuid":                                basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"config_hash":                         v.ConfigHash,
+			"connect_to_docker_network":           v.ConnectToDockerNetwork,
+			"created_at":                          v.CreatedAt,
+			"deleted_at":                          v.DeletedAt,
+			"description":                         v.Description,
+			"destination_id":                      v.DestinationId,
+			"destination_type":                    v.DestinationType,
+			"docker_compose":                      v.DockerCompose,
+			"docker_compose_raw":                  v.DockerComposeRaw,
+			"environment_id":                      v.EnvironmentId,
+			"id":                                  v.Id,
+			"is_container_label_escape_enabled":   v.IsContainerLabelEscapeEnabled,
+			"is_container_label_readonly_enabled": v.IsContainerLabelReadonlyEnabled,
+			"name":                                v.Name,
+			"server_id":                           v.ServerId,
+			"service_type":                        v.ServiceType,
+			"updated_at":                          v.UpdatedAt,
+			"uuid":                                v.Uuid,
+		})
+
+	return objVal, diags
+}
+
+func (v ServicesValue) Equal(o attr.Value) bool {
+	other, ok := o.(ServicesValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.ConfigHash.Equal(other.ConfigHash) {
+		return false
+	}
+
+	if !v.ConnectToDockerNetwork.Equal(other.ConnectToDockerNetwork) {
+		return false
+	}
+
+	if !v.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+
+	if !v.DeletedAt.Equal(other.DeletedAt) {
+		return false
+	}
+
+	if !v.Description.Equal(other.Description) {
+		return false
+	}
+
+	if !v.DestinationId.Equal(other.DestinationId) {
+		return false
+	}
+
+	if !v.DestinationType.Equal(other.DestinationType) {
+		return false
+	}
+
+	if !v.DockerCompose.Equal(other.DockerCompose) {
+		return false
+	}
+
+	if !v.DockerComposeRaw.Equal(other.DockerComposeRaw) {
+		return false
+	}
+
+	if !v.EnvironmentId.Equal(other.EnvironmentId) {
+		return false
+	}
+
+	if !v.Id.Equal(other.Id) {
+		return false
+	}
+
+	if !v.IsContainerLabelEscapeEnabled.Equal(other.IsContainerLabelEscapeEnabled) {
+		return false
+	}
+
+	if !v.IsContainerLabelReadonlyEnabled.Equal(other.IsContainerLabelReadonlyEnabled) {
+		return false
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.ServerId.Equal(other.ServerId) {
+		return false
+	}
+
+	if !v.ServiceType.Equal(other.ServiceType) {
+		return false
+	}
+
+	if !v.UpdatedAt.Equal(other.UpdatedAt) {
+		return false
+	}
+
+	if !v.Uuid.Equal(other.Uuid) {
+		return false
+	}
+
+	return true
+}
+
+func (v ServicesValue) Type(ctx context.Context) attr.Type {
+	return ServicesType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ServicesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"config_hash":                         basetypes.StringType{},
+		"connect_to_docker_network":           basetypes.BoolType{},
+		"created_at":                          basetypes.StringType{},
+		"deleted_at":                          basetypes.StringType{},
+		"description":                         basetypes.StringType{},
+		"destination_id":                      basetypes.Int64Type{},
+		"destination_type":                    basetypes.StringType{},
+		"docker_compose":                      basetypes.StringType{},
+		"docker_compose_raw":                  basetypes.StringType{},
+		"environment_id":                      basetypes.Int64Type{},
+		"id":                                  basetypes.Int64Type{},
+		"is_container_label_escape_enabled":   basetypes.BoolType{},
+		"is_container_label_readonly_enabled": basetypes.BoolType{},
+		"name":                                basetypes.StringType{},
+		"server_id":                           basetypes.Int64Type{},
+		"service_type":                        basetypes.StringType{},
+		"updated_at":                          basetypes.StringType{},
+		"uuid":                                basetypes.StringType{},
+	}
+}
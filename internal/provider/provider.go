@@ -6,10 +6,14 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -20,6 +24,7 @@ import (
 
 	"terraform-provider-coolify/internal/api"
 	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/provider/util"
 	"terraform-provider-coolify/internal/service"
 	"terraform-provider-coolify/internal/service/private_key"
 )
@@ -29,6 +34,8 @@ var (
 	_ provider.Provider                       = &CoolifyProvider{}
 	_ provider.ProviderWithFunctions          = &CoolifyProvider{}
 	_ provider.ProviderWithEphemeralResources = &CoolifyProvider{}
+	_ provider.ProviderWithListResources      = &CoolifyProvider{}
+	_ provider.ProviderWithActions            = &CoolifyProvider{}
 )
 
 // CoolifyProvider defines the provider implementation.
@@ -43,6 +50,9 @@ type CoolifyProviderModel struct {
 	Endpoint types.String      `tfsdk:"endpoint"`
 	Token    types.String      `tfsdk:"token"`
 	Retry    *RetryConfigModel `tfsdk:"retry"`
+
+	RateLimit             *RateLimitConfigModel `tfsdk:"rate_limit"`
+	MaxConcurrentRequests types.Int64           `tfsdk:"max_concurrent_requests"`
 }
 
 type RetryConfigModel struct {
@@ -51,6 +61,11 @@ type RetryConfigModel struct {
 	MaxWait  types.Int64 `tfsdk:"max_wait"`
 }
 
+type RateLimitConfigModel struct {
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &CoolifyProvider{
@@ -91,7 +106,7 @@ func (p *CoolifyProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Attributes: map[string]schema.Attribute{
 					"attempts": schema.Int64Attribute{
 						Optional:    true,
-						Description: fmt.Sprintf("Maximum number of retries for HTTP requests. Default: %d", consts.DEFAULT_RETRY_ATTEMPTS),
+						Description: fmt.Sprintf("Maximum number of retries for HTTP requests. Requests that create resources are only retried when they could not be sent. Default: %d", consts.DEFAULT_RETRY_ATTEMPTS),
 					},
 					"min_wait": schema.Int64Attribute{
 						Optional:    true,
@@ -99,10 +114,31 @@ func (p *CoolifyProvider) Schema(ctx context.Context, req provider.SchemaRequest
 					},
 					"max_wait": schema.Int64Attribute{
 						Optional:    true,
-						Description: fmt.Sprintf("Maximum time to wait between retries in seconds. Default: %d", consts.DEFAULT_RETRY_MAX_WAIT),
+						Description: fmt.Sprintf("Maximum time to wait between retries in seconds. The `Retry-After` header of rate limited responses takes precedence, up to 5 minutes. Default: %d", consts.DEFAULT_RETRY_MAX_WAIT),
+					},
+				},
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Client-side rate limit shared by all API requests, ie to stay below the rate limit of Coolify Cloud API tokens. Not limited by default.",
+				Attributes: map[string]schema.Attribute{
+					"requests_per_second": schema.Float64Attribute{
+						Required:    true,
+						Validators:  []validator.Float64{float64validator.AtLeast(0.01)},
+						Description: "Average number of requests sent per second.",
+					},
+					"burst": schema.Int64Attribute{
+						Optional:    true,
+						Validators:  []validator.Int64{int64validator.AtLeast(1)},
+						Description: "Maximum number of requests sent at once, before the average rate applies. Default: 1",
 					},
 				},
 			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+				Description: "Maximum number of API requests in flight at the same time. Not limited by default.",
+			},
 		},
 	}
 }
@@ -129,6 +165,22 @@ func GetRetryConfig(config *RetryConfigModel) api.RetryConfig {
 	return retryConfig
 }
 
+func GetLimitConfig(rateLimit *RateLimitConfigModel, maxConcurrentRequests types.Int64) api.LimitConfig {
+	limitConfig := api.LimitConfig{
+		MaxConcurrentRequests: maxConcurrentRequests.ValueInt64(),
+	}
+
+	if rateLimit != nil {
+		limitConfig.RequestsPerSecond = rateLimit.RequestsPerSecond.ValueFloat64()
+		limitConfig.Burst = 1
+		if !rateLimit.Burst.IsNull() {
+			limitConfig.Burst = rateLimit.Burst.ValueInt64()
+		}
+	}
+
+	return limitConfig
+}
+
 func (p *CoolifyProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data CoolifyProviderModel
 
@@ -167,7 +219,7 @@ func (p *CoolifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	client, err := api.NewAPIClient(p.version, apiEndpoint, apiToken, GetRetryConfig(data.Retry))
+	client, err := api.NewAPIClient(p.version, apiEndpoint, apiToken, GetRetryConfig(data.Retry), GetLimitConfig(data.RateLimit, data.MaxConcurrentRequests))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create API client",
@@ -186,10 +238,7 @@ func (p *CoolifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	if versionResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code API client",
-			fmt.Sprintf("Received %s creating API client. Details: %s", versionResp.Status(), versionResp.Body),
-		)
+		util.AddAPIError(&resp.Diagnostics, "creating API client", versionResp.HTTPResponse, versionResp.Body, nil)
 		return
 	}
 
@@ -207,14 +256,20 @@ func (p *CoolifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	resp.ResourceData = client
 	resp.DataSourceData = client
+	resp.ListResourceData = client
+	resp.ActionData = client
 }
 
 func (p *CoolifyProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		private_key.NewPrivateKeyResource,
 		service.NewServerResource,
+		service.NewServerSettingsResource,
+		service.NewServerLogDrainResource,
 		service.NewProjectResource,
 		service.NewApplicationEnvsResource,
+		service.NewApplicationSwarmResource,
+		service.NewApplicationCommandResource,
 		service.NewServiceEnvsResource,
 		service.NewPostgresqlDatabaseResource,
 		service.NewMySQLDatabaseResource,
@@ -231,11 +286,38 @@ func (p *CoolifyProvider) DataSources(ctx context.Context) []func() datasource.D
 		service.NewServersDataSource,
 		service.NewServerResourcesDataSource,
 		service.NewServerDomainsDataSource,
+		service.NewServerMetricsDataSource,
 		service.NewProjectDataSource,
 		service.NewProjectsDataSource,
 		service.NewApplicationDataSource,
 		service.NewApplicationsDataSource,
 		service.NewServiceDataSource,
+		service.NewServicesDataSource,
+		service.NewResourcesDataSource,
+		service.NewInventoryDataSource,
+		service.NewDatabaseDataSource,
+		service.NewDatabasesDataSource,
+	}
+}
+
+func (p *CoolifyProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		private_key.NewPrivateKeyListResource,
+		service.NewServerListResource,
+		service.NewProjectListResource,
+		service.NewPostgresqlDatabaseListResource,
+		service.NewMySQLDatabaseListResource,
+	}
+}
+
+func (p *CoolifyProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		service.NewApplicationLifecycleAction,
+		service.NewDatabaseLifecycleAction,
+		service.NewServiceLifecycleAction,
+		service.NewDeployAction,
+		service.NewServerValidateAction,
+		service.NewApplicationExecuteCommandAction,
 	}
 }
 
@@ -233,3 +233,46 @@ func TestGetRetryConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLimitConfig(t *testing.T) {
+	testCases := map[string]struct {
+		rateLimit             *provider.RateLimitConfigModel
+		maxConcurrentRequests types.Int64
+		expected              api.LimitConfig
+	}{
+		"no limits": {
+			rateLimit:             nil,
+			maxConcurrentRequests: types.Int64Null(),
+			expected:              api.LimitConfig{},
+		},
+		"rate limit without burst": {
+			rateLimit: &provider.RateLimitConfigModel{
+				RequestsPerSecond: types.Float64Value(2.5),
+			},
+			maxConcurrentRequests: types.Int64Null(),
+			expected: api.LimitConfig{
+				RequestsPerSecond: 2.5,
+				Burst:             1,
+			},
+		},
+		"full config": {
+			rateLimit: &provider.RateLimitConfigModel{
+				RequestsPerSecond: types.Float64Value(5),
+				Burst:             types.Int64Value(10),
+			},
+			maxConcurrentRequests: types.Int64Value(4),
+			expected: api.LimitConfig{
+				RequestsPerSecond:     5,
+				Burst:                 10,
+				MaxConcurrentRequests: 4,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := provider.GetLimitConfig(tc.rateLimit, tc.maxConcurrentRequests)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
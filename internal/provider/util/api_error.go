@@ -0,0 +1,97 @@
+package util
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+)
+
+// apiErrorDiagnostic is an error diagnostic that remembers the HTTP status it was created for,
+// so a missing resource can be told apart from other errors.
+type apiErrorDiagnostic struct {
+	diag.Diagnostic
+	statusCode int
+}
+
+// AddAPIError adds the diagnostics for an unsuccessful response from the Coolify API.
+// Validation errors are reported on the attribute of the same name in model, when there is one.
+// The operation describes what failed, ie `creating postgresql database`.
+func AddAPIError(diags *diag.Diagnostics, operation string, resp *http.Response, body []byte, model any) {
+	var paths map[string]path.Path
+	if model != nil {
+		paths = map[string]path.Path{}
+		for name := range filter.StructAttributes(model) {
+			paths[name] = path.Root(name)
+		}
+	}
+	AddAPIErrorAt(diags, operation, resp, body, paths)
+}
+
+// AddAPIErrorAt adds the diagnostics for an unsuccessful response from the Coolify API,
+// reporting validation errors on the attribute that paths maps the field to.
+// It is used when the Coolify field names differ from the attributes, ie for nested attributes.
+func AddAPIErrorAt(diags *diag.Diagnostics, operation string, resp *http.Response, body []byte, paths map[string]path.Path) {
+	apiErr := api.DecodeError(resp, body)
+	summary := apiErr.Summary(operation)
+
+	unmatched := map[string][]string{}
+	for _, field := range apiErr.FieldNames() {
+		attributePath, ok := paths[field]
+		if !ok {
+			unmatched[field] = apiErr.Fields[field]
+			continue
+		}
+		diags.AddAttributeError(attributePath, summary, strings.Join(apiErr.Fields[field], "\n"))
+	}
+
+	if len(apiErr.Fields) > 0 && len(unmatched) == 0 {
+		return
+	}
+
+	remaining := *apiErr
+	remaining.Fields = unmatched
+	diags.Append(apiErrorDiagnostic{
+		Diagnostic: diag.NewErrorDiagnostic(summary, remaining.Detail()),
+		statusCode: apiErr.StatusCode,
+	})
+}
+
+// AttributePaths maps the attributes of model to their path under base, ie for the errors of a nested object.
+// Each attribute is mapped from prefix followed by its name.
+func AttributePaths(model any, prefix string, base path.Path) map[string]path.Path {
+	paths := map[string]path.Path{}
+	for name := range filter.StructAttributes(model) {
+		paths[prefix+name] = base.AtName(name)
+	}
+	return paths
+}
+
+// RemoveResourceIfNotFound removes the resource from the state when the diagnostics report that it no longer exists in Coolify,
+// so Terraform plans to create it again instead of failing.
+func RemoveResourceIfNotFound(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State) bool {
+	remaining := diag.Diagnostics{}
+	found := false
+	for _, d := range *diags {
+		if apiDiag, ok := d.(apiErrorDiagnostic); ok && apiDiag.statusCode == http.StatusNotFound {
+			found = true
+			continue
+		}
+		remaining = append(remaining, d)
+	}
+	if !found {
+		return false
+	}
+
+	tflog.Warn(ctx, "Resource not found in Coolify, removing it from the state")
+	*diags = remaining
+	state.RemoveResource(ctx)
+	return true
+}
@@ -0,0 +1,153 @@
+package util
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type mockCommonModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type mockResourceModel struct {
+	mockCommonModel
+	PostgresUser types.String `tfsdk:"postgres_user"`
+}
+
+func TestAddAPIError(t *testing.T) {
+	t.Parallel()
+	body := []byte(`{"message":"Validation failed.","errors":{"postgres_user":["The postgres user field must be a string."],"name":["The name has already been taken."],"instant_deploy":["Unknown field."]}}`)
+	resp := &http.Response{StatusCode: http.StatusUnprocessableEntity, Status: "422 Unprocessable Content"}
+
+	t.Run("AttributeErrors", func(t *testing.T) {
+		t.Parallel()
+		var diags diag.Diagnostics
+		AddAPIError(&diags, "creating postgresql database", resp, body, mockResourceModel{})
+
+		if len(diags) != 3 {
+			t.Fatalf("expected 3 diagnostics, got %d: %v", len(diags), diags)
+		}
+		attributes := map[string]bool{}
+		for _, d := range diags {
+			if d.Summary() != "Invalid attributes creating postgresql database" {
+				t.Errorf("unexpected summary %q", d.Summary())
+			}
+			if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+				attributes[withPath.Path().String()] = true
+				continue
+			}
+			if !strings.Contains(d.Detail(), "instant_deploy: Unknown field.") || strings.Contains(d.Detail(), "postgres_user") {
+				t.Errorf("expected only the unmatched field in the detail, got %q", d.Detail())
+			}
+		}
+		for _, name := range []string{"name", "postgres_user"} {
+			if !attributes[path.Root(name).String()] {
+				t.Errorf("expected an attribute error on %s", name)
+			}
+		}
+	})
+
+	t.Run("WithoutModel", func(t *testing.T) {
+		t.Parallel()
+		var diags diag.Diagnostics
+		AddAPIError(&diags, "creating postgresql database", resp, body, nil)
+
+		if len(diags) != 1 {
+			t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+		}
+		if !strings.Contains(diags[0].Detail(), "postgres_user") {
+			t.Errorf("expected the fields in the detail, got %q", diags[0].Detail())
+		}
+	})
+}
+
+func TestAddAPIErrorAt(t *testing.T) {
+	t.Parallel()
+	body := []byte(`{"message":"Validation failed.","errors":{"data.1.key":["The key field is required."],"data.3.value":["Unknown item."]}}`)
+	resp := &http.Response{StatusCode: http.StatusUnprocessableEntity, Status: "422 Unprocessable Content"}
+
+	paths := map[string]path.Path{
+		"data.0.key": path.Root("env").AtListIndex(0).AtName("key"),
+		"data.1.key": path.Root("env").AtListIndex(1).AtName("key"),
+	}
+
+	var diags diag.Diagnostics
+	AddAPIErrorAt(&diags, "updating envs", resp, body, paths)
+
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+	}
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("env").AtListIndex(1).AtName("key")) {
+		t.Errorf("expected an attribute error on env[1].key, got %v", diags[0])
+	}
+	if !strings.Contains(diags[1].Detail(), "data.3.value") {
+		t.Errorf("expected the unmatched field in the detail, got %q", diags[1].Detail())
+	}
+}
+
+func TestAttributePaths(t *testing.T) {
+	t.Parallel()
+	paths := AttributePaths(mockResourceModel{}, "logdrain_", path.Root("custom"))
+
+	expected := map[string]path.Path{
+		"logdrain_name":          path.Root("custom").AtName("name"),
+		"logdrain_postgres_user": path.Root("custom").AtName("postgres_user"),
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected %d paths, got %v", len(expected), paths)
+	}
+	for field, attributePath := range expected {
+		if !paths[field].Equal(attributePath) {
+			t.Errorf("expected %s for %s, got %s", attributePath, field, paths[field])
+		}
+	}
+}
+
+func TestRemoveResourceIfNotFound(t *testing.T) {
+	t.Parallel()
+	newState := func() *tfsdk.State {
+		objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"uuid": tftypes.String}}
+		return &tfsdk.State{
+			Schema: schema.Schema{Attributes: map[string]schema.Attribute{"uuid": schema.StringAttribute{Required: true}}},
+			Raw:    tftypes.NewValue(objectType, map[string]tftypes.Value{"uuid": tftypes.NewValue(tftypes.String, "abc")}),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		statusCode int
+		removed    bool
+	}{
+		{"NotFound", http.StatusNotFound, true},
+		{"ServerError", http.StatusInternalServerError, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			state := newState()
+			AddAPIError(&diags, "reading project", &http.Response{StatusCode: tt.statusCode}, nil, nil)
+
+			if got := RemoveResourceIfNotFound(context.Background(), &diags, state); got != tt.removed {
+				t.Errorf("expected %v, got %v", tt.removed, got)
+			}
+			if state.Raw.IsNull() != tt.removed {
+				t.Errorf("expected the state to be removed: %v", tt.removed)
+			}
+			if diags.HasError() == tt.removed {
+				t.Errorf("expected errors to remain: %v, got %v", !tt.removed, diags)
+			}
+		})
+	}
+}
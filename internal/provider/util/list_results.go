@@ -0,0 +1,26 @@
+package util
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// ListResults streams a list result for each item, filled in by fill.
+// It stops once Terraform has received the number of results it asked for.
+func ListResults[T any](ctx context.Context, req list.ListRequest, items []T, fill func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			fill(item, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestListResults(t *testing.T) {
+	t.Parallel()
+	items := []string{"a", "b", "c"}
+	tests := []struct {
+		name     string
+		limit    int64
+		stopAt   int
+		expected []string
+	}{
+		{"NoLimit", 0, 0, []string{"a", "b", "c"}},
+		{"Limit", 2, 0, []string{"a", "b"}},
+		{"LimitAboveCount", 10, 0, []string{"a", "b", "c"}},
+		{"StoppedByConsumer", 0, 1, []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			req := list.ListRequest{
+				Limit:                  tt.limit,
+				ResourceSchema:         schema.Schema{},
+				ResourceIdentitySchema: identityschema.Schema{},
+			}
+
+			var got []string
+			for result := range ListResults(ctx, req, items, func(item string, result *list.ListResult) {
+				result.DisplayName = item
+			}) {
+				got = append(got, result.DisplayName)
+				if tt.stopAt > 0 && len(got) == tt.stopAt {
+					break
+				}
+			}
+
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("expected %v, got %v", tt.expected, got)
+				}
+			}
+		})
+	}
+}
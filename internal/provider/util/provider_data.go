@@ -1,6 +1,7 @@
 package util
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -36,3 +37,19 @@ func ProviderDataFromResourceConfigureRequest[ProviderData interface{}](req reso
 
 	return false
 }
+
+func ProviderDataFromActionConfigureRequest[ProviderData interface{}](req action.ConfigureRequest, out *ProviderData, resp *action.ConfigureResponse) bool {
+	if req.ProviderData == nil {
+		return false
+	}
+
+	if providerData, ok := req.ProviderData.(ProviderData); ok {
+		*out = providerData
+
+		return true
+	}
+
+	resp.Diagnostics.AddError("Invalid provider data", "")
+
+	return false
+}
@@ -3,6 +3,7 @@ package util
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -95,3 +96,45 @@ func TestProviderDataFromResourceConfigureRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderDataFromActionConfigureRequest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		providerData  any
+		expected      bool
+		expectError   bool
+		expectedValue string
+	}{
+		{"NilProviderData", nil, false, false, ""},
+		{"ValidProviderData", mockProviderData{Value: "test"}, true, false, "test"},
+		{"InvalidProviderData", "invalid", false, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := action.ConfigureRequest{ProviderData: tt.providerData}
+			resp := &action.ConfigureResponse{Diagnostics: diag.Diagnostics{}}
+			var out mockProviderData
+
+			got := ProviderDataFromActionConfigureRequest(req, &out, resp)
+
+			if got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+
+			if tt.expectError && len(resp.Diagnostics) == 0 {
+				t.Error("expected error diagnostics, got none")
+			}
+
+			if !tt.expectError && len(resp.Diagnostics) > 0 {
+				t.Error("expected no error diagnostics, got some")
+			}
+
+			if tt.expected && out.Value != tt.expectedValue {
+				t.Errorf("expected value %s, got %s", tt.expectedValue, out.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/flatten"
+)
+
+// actionPollInterval is the time between checks while an action waits for Coolify to finish.
+var actionPollInterval = 5 * time.Second
+
+// actionProgress returns a function that reports progress messages to the Terraform CLI.
+func actionProgress(ctx context.Context, resp *action.InvokeResponse) func(message string) {
+	return func(message string) {
+		tflog.Info(ctx, message)
+		if resp.SendProgress != nil {
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		}
+	}
+}
+
+// messageFromBody extracts the message that Coolify returns with most responses.
+func messageFromBody(body []byte) string {
+	var response struct {
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(body, &response)
+	return response.Message
+}
+
+// statusFromBody extracts the status of an application, database or service from a raw response.
+// It is not part of every documented API model, so anything unexpected is ignored.
+func statusFromBody(body []byte) string {
+	var response struct {
+		Status string `json:"status"`
+	}
+	_ = json.Unmarshal(body, &response)
+	return response.Status
+}
+
+// deploymentUuidFromBody extracts the UUID of the deployment queued by starting or restarting an application.
+func deploymentUuidFromBody(body []byte) string {
+	var response struct {
+		DeploymentUuid string `json:"deployment_uuid"`
+	}
+	_ = json.Unmarshal(body, &response)
+	return response.DeploymentUuid
+}
+
+// deploymentLogLines extracts the visible output lines from the logs of a deployment, which are a JSON encoded list of entries.
+func deploymentLogLines(logs string) []string {
+	var entries []struct {
+		Output string `json:"output"`
+		Hidden bool   `json:"hidden"`
+	}
+	if err := json.Unmarshal([]byte(logs), &entries); err != nil {
+		return nil
+	}
+
+	var lines []string
+	for _, entry := range entries {
+		if entry.Hidden {
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimRight(entry.Output, "\n"), "\n") {
+			if strings.TrimSpace(line) != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
+
+// waitForDeployment polls a deployment until it is finished, or ctx is done.
+// New log lines and status changes are reported through progress.
+func waitForDeployment(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	uuid string,
+	progress func(message string),
+) {
+	ticker := time.NewTicker(actionPollInterval)
+	defer ticker.Stop()
+
+	var status, lastErr string
+	var printed int
+	for {
+		readResp, err := client.GetDeploymentByUuidWithResponse(ctx, uuid)
+		switch {
+		case err != nil:
+			if ctx.Err() == nil {
+				lastErr = err.Error()
+			}
+		case readResp.StatusCode() != http.StatusOK || readResp.JSON200 == nil:
+			lastErr = api.DecodeError(readResp.HTTPResponse, readResp.Body).Error()
+		default:
+			lastErr = ""
+			lines := deploymentLogLines(flatten.String(readResp.JSON200.Logs).ValueString())
+			for _, line := range lines[min(printed, len(lines)):] {
+				progress(line)
+			}
+			printed = max(printed, len(lines))
+
+			if current := flatten.String(readResp.JSON200.Status).ValueString(); current != status {
+				status = current
+				progress(fmt.Sprintf("Deployment %s is %s", uuid, status))
+			}
+
+			switch status {
+			case "finished":
+				return
+			case "failed", "cancelled-by-user":
+				diags.AddError(
+					fmt.Sprintf("Deployment did not finish: uuid=%s", uuid),
+					fmt.Sprintf("The deployment status is %s. See the deployment logs in Coolify for details.", status),
+				)
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			detail := fmt.Sprintf("The deployment was still %q when the timeout expired.", status)
+			if lastErr != "" {
+				detail += "\nLast error reading the deployment: " + lastErr
+			}
+			diags.AddError(fmt.Sprintf("Deployment did not finish: uuid=%s", uuid), detail)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForStatus polls the status returned by read until done accepts it, or ctx is done.
+// Status changes are reported through progress, and the last read error is reported on timeout.
+func waitForStatus(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	description string,
+	read func(ctx context.Context) (string, error),
+	done func(status string) bool,
+	progress func(message string),
+) {
+	ticker := time.NewTicker(actionPollInterval)
+	defer ticker.Stop()
+
+	var status, lastErr string
+	for {
+		current, err := read(ctx)
+		switch {
+		case err != nil:
+			if ctx.Err() == nil {
+				lastErr = err.Error()
+			}
+		case current != "" && current != status:
+			lastErr = ""
+			status = current
+			progress(fmt.Sprintf("%s is %s", description, status))
+		default:
+			lastErr = ""
+		}
+		if status != "" && done(status) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			detail := fmt.Sprintf("The status was still %q when the timeout expired.", status)
+			if lastErr != "" {
+				detail += "\nLast error reading the status: " + lastErr
+			}
+			diags.AddError(fmt.Sprintf("%s did not reach the expected status", description), detail)
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestLifecycleActionInvoke(t *testing.T) {
+	defer func(interval time.Duration) { actionPollInterval = interval }(actionPollInterval)
+	actionPollInterval = time.Millisecond
+
+	// newDatabase serves a database whose status follows statuses, repeating the last one
+	newDatabase := func(t *testing.T, operation string, statuses ...string) (*api.ClientWithResponses, *atomic.Int32) {
+		var reads atomic.Int32
+		client := newActionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/databases/d1/" + operation:
+				fmt.Fprint(w, `{"message": "Request queued."}`)
+			case "/databases/d1":
+				read := int(reads.Add(1))
+				fmt.Fprintf(w, `{"uuid": "d1", "status": %q}`, statuses[min(read, len(statuses))-1])
+			default:
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		return client, &reads
+	}
+
+	t.Run("restart waits for the status to go down and come back", func(t *testing.T) {
+		client, reads := newDatabase(t, "restart", "running:healthy", "running:healthy", "restarting", "exited", "running:healthy")
+
+		resp, messages := invokeAction(t, &lifecycleAction{client: client, target: databaseLifecycle}, map[string]tftypes.Value{
+			"uuid":      tftypes.NewValue(tftypes.String, "d1"),
+			"operation": tftypes.NewValue(tftypes.String, "restart"),
+		})
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, int32(5), reads.Load())
+		assert.Contains(t, messages, "The database d1 is restarting")
+		assert.Equal(t, "The database d1 is running:healthy", messages[len(messages)-1])
+	})
+
+	t.Run("restart which never leaves running succeeds after the grace period", func(t *testing.T) {
+		defer func(period time.Duration) { restartGracePeriod = period }(restartGracePeriod)
+		restartGracePeriod = 20 * time.Millisecond
+
+		client, _ := newDatabase(t, "restart", "running:healthy")
+
+		resp, messages := invokeAction(t, &lifecycleAction{client: client, target: databaseLifecycle}, map[string]tftypes.Value{
+			"uuid":      tftypes.NewValue(tftypes.String, "d1"),
+			"operation": tftypes.NewValue(tftypes.String, "restart"),
+		})
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Contains(t, strings.Join(messages, "\n"), "assuming it restarted")
+	})
+
+	t.Run("restart which never leaves running times out within the grace period", func(t *testing.T) {
+		client, _ := newDatabase(t, "restart", "running:healthy")
+
+		resp, _ := invokeAction(t, &lifecycleAction{client: client, target: databaseLifecycle}, map[string]tftypes.Value{
+			"uuid":      tftypes.NewValue(tftypes.String, "d1"),
+			"operation": tftypes.NewValue(tftypes.String, "restart"),
+			"timeout":   tftypes.NewValue(tftypes.Number, 1),
+		})
+
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `"running:healthy"`)
+	})
+
+	t.Run("start of a running database does not wait", func(t *testing.T) {
+		client, reads := newDatabase(t, "start", "running:healthy")
+
+		resp, _ := invokeAction(t, &lifecycleAction{client: client, target: databaseLifecycle}, map[string]tftypes.Value{
+			"uuid":      tftypes.NewValue(tftypes.String, "d1"),
+			"operation": tftypes.NewValue(tftypes.String, "start"),
+		})
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, int32(1), reads.Load())
+	})
+
+	t.Run("stop waits for exited", func(t *testing.T) {
+		client, reads := newDatabase(t, "stop", "running:healthy", "exited")
+
+		resp, _ := invokeAction(t, &lifecycleAction{client: client, target: databaseLifecycle}, map[string]tftypes.Value{
+			"uuid":      tftypes.NewValue(tftypes.String, "d1"),
+			"operation": tftypes.NewValue(tftypes.String, "stop"),
+		})
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, int32(2), reads.Load())
+	})
+
+	t.Run("timeout reports the last read error", func(t *testing.T) {
+		client := newActionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/databases/d1/stop" {
+				fmt.Fprint(w, `{"message": "Request queued."}`)
+				return
+			}
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "You are not allowed to access this database."}`)
+		})
+
+		resp, _ := invokeAction(t, &lifecycleAction{client: client, target: databaseLifecycle}, map[string]tftypes.Value{
+			"uuid":      tftypes.NewValue(tftypes.String, "d1"),
+			"operation": tftypes.NewValue(tftypes.String, "stop"),
+			"timeout":   tftypes.NewValue(tftypes.Number, 1),
+		})
+
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "You are not allowed to access this database.")
+	})
+}
+
+func TestDeployActionInvoke(t *testing.T) {
+	defer func(interval time.Duration) { actionPollInterval = interval }(actionPollInterval)
+	actionPollInterval = time.Millisecond
+
+	deployBody := `{"deployments": [{"message": "Deployment queued.", "resource_uuid": "a1", "deployment_uuid": "dp1"}]}`
+
+	t.Run("follows the deployment", func(t *testing.T) {
+		var reads atomic.Int32
+		client := newActionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/deploy":
+				assert.Equal(t, "a1", r.URL.Query().Get("uuid"))
+				fmt.Fprint(w, deployBody)
+			case "/deployments/dp1":
+				status := "in_progress"
+				if reads.Add(1) >= 2 {
+					status = "finished"
+				}
+				fmt.Fprintf(w, `{"status": %q, "logs": "[{\"output\": \"Building.\", \"hidden\": false}]"}`, status)
+			default:
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		resp, messages := invokeAction(t, &deployAction{client: client}, map[string]tftypes.Value{
+			"uuids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "a1")}),
+		})
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, []string{
+			"a1: Deployment queued.",
+			"Building.",
+			"Deployment dp1 is in_progress",
+			"Deployment dp1 is finished",
+		}, messages)
+	})
+
+	t.Run("failed deployment", func(t *testing.T) {
+		client := newActionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/deploy" {
+				fmt.Fprint(w, deployBody)
+				return
+			}
+			fmt.Fprint(w, `{"status": "failed"}`)
+		})
+
+		resp, _ := invokeAction(t, &deployAction{client: client}, map[string]tftypes.Value{
+			"uuids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "a1")}),
+		})
+
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "The deployment status is failed.")
+	})
+
+	t.Run("timeout reports the last read error", func(t *testing.T) {
+		client := newActionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/deploy" {
+				fmt.Fprint(w, deployBody)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "Deployment not found."}`)
+		})
+
+		resp, _ := invokeAction(t, &deployAction{client: client}, map[string]tftypes.Value{
+			"uuids":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "a1")}),
+			"timeout": tftypes.NewValue(tftypes.Number, 1),
+		})
+
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "Last error reading the deployment")
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "Deployment not found.")
+	})
+}
+
+func newActionTestClient(t *testing.T, handler http.HandlerFunc) *api.ClientWithResponses {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+	require.NoError(t, err)
+	return client
+}
+
+// invokeAction invokes a with the given configuration, leaving the other attributes null, and returns the progress messages.
+func invokeAction(t *testing.T, a action.Action, config map[string]tftypes.Value) (*action.InvokeResponse, []string) {
+	ctx := context.Background()
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := config[name]; ok {
+			values[name] = value
+		}
+	}
+
+	var messages []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) { messages = append(messages, event.Message) },
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+
+	return resp, messages
+}
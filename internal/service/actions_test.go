@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageFromBody(t *testing.T) {
+	assert.Equal(t, "Restart request queued.", messageFromBody([]byte(`{"message": "Restart request queued."}`)))
+	assert.Equal(t, "", messageFromBody([]byte(`not json`)))
+}
+
+func TestStatusFromBody(t *testing.T) {
+	assert.Equal(t, "running:healthy", statusFromBody([]byte(`{"uuid": "a1", "status": "running:healthy"}`)))
+	assert.Equal(t, "", statusFromBody([]byte(`{"uuid": "a1"}`)))
+}
+
+func TestDeploymentUuidFromBody(t *testing.T) {
+	assert.Equal(t, "d1", deploymentUuidFromBody([]byte(`{"message": "Restart request queued.", "deployment_uuid": "d1"}`)))
+	assert.Equal(t, "", deploymentUuidFromBody([]byte(`{"message": "Database restart request queued."}`)))
+}
+
+func TestDeploymentLogLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		logs     string
+		expected []string
+	}{
+		{
+			"visible entries",
+			`[
+				{"output": "Starting deployment.", "hidden": false},
+				{"output": "docker inspect", "hidden": true},
+				{"output": "Pulling image.\nImage pulled.\n", "hidden": false}
+			]`,
+			[]string{"Starting deployment.", "Pulling image.", "Image pulled."},
+		},
+		{"empty", "", nil},
+		{"invalid", "not json", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, deploymentLogLines(tt.logs))
+		})
+	}
+}
+
+func TestWaitForStatus(t *testing.T) {
+	defer func(interval time.Duration) { actionPollInterval = interval }(actionPollInterval)
+	actionPollInterval = time.Millisecond
+
+	t.Run("reaches status", func(t *testing.T) {
+		statuses := []string{"restarting", "restarting", "running:healthy"}
+		var messages []string
+		var diags diag.Diagnostics
+
+		waitForStatus(context.Background(), &diags, "The database d1",
+			func(ctx context.Context) (string, error) {
+				status := statuses[0]
+				if len(statuses) > 1 {
+					statuses = statuses[1:]
+				}
+				return status, nil
+			},
+			func(status string) bool { return strings.HasPrefix(status, "running") },
+			func(message string) { messages = append(messages, message) },
+		)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, []string{"The database d1 is restarting", "The database d1 is running:healthy"}, messages)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		var diags diag.Diagnostics
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		waitForStatus(ctx, &diags, "The service s1",
+			func(ctx context.Context) (string, error) { return "running:healthy", nil },
+			func(status string) bool { return strings.HasPrefix(status, "exited") },
+			func(message string) {},
+		)
+
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("timeout reports last error", func(t *testing.T) {
+		var diags diag.Diagnostics
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		waitForStatus(ctx, &diags, "The service s1",
+			func(ctx context.Context) (string, error) { return "", errors.New("service not found") },
+			func(status string) bool { return strings.HasPrefix(status, "running") },
+			func(message string) {},
+		)
+
+		if assert.True(t, diags.HasError()) {
+			assert.Contains(t, diags.Errors()[0].Detail(), "Last error reading the status: service not found")
+		}
+	})
+}
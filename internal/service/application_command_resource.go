@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ resource.Resource              = &applicationCommandResource{}
+	_ resource.ResourceWithConfigure = &applicationCommandResource{}
+)
+
+func NewApplicationCommandResource() resource.Resource {
+	return &applicationCommandResource{}
+}
+
+type applicationCommandResource struct {
+	client *api.ClientWithResponses
+}
+
+type applicationCommandResourceModel struct {
+	ApplicationUuid types.String `tfsdk:"application_uuid"`
+	Command         types.String `tfsdk:"command"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	Timeout         types.Int64  `tfsdk:"timeout"`
+	Sensitive       types.Bool   `tfsdk:"sensitive"`
+	ExitCode        types.Int64  `tfsdk:"exit_code"`
+	Output          types.String `tfsdk:"output"`
+	SensitiveOutput types.String `tfsdk:"sensitive_output"`
+}
+
+func (r *applicationCommandResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_command"
+}
+
+func (r *applicationCommandResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Run a command in the container of a Coolify application, ie database migrations after a deployment." +
+			"\nThe command runs when the resource is created, and again whenever `application_uuid`, `command` or `triggers` change." +
+			" A non-zero or unreported exit code fails the apply." +
+			"\n**NOTE:** Destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"application_uuid": schema.StringAttribute{
+				Required:      true,
+				Description:   "UUID of the application.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"command": schema.StringAttribute{
+				Required:      true,
+				Description:   "Command to run in the application container.",
+				Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:      true,
+				ElementType:   types.StringType,
+				Description:   "Arbitrary values that run the command again when they change, ie the UUID of the latest deployment.",
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(consts.DEFAULT_ACTION_TIMEOUT),
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+				Description: fmt.Sprintf("Maximum time to wait for the command in seconds. The timeout only stops waiting, the command keeps running in the container. Default: `%d`.", consts.DEFAULT_ACTION_TIMEOUT),
+			},
+			"sensitive": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Store the output in `sensitive_output` instead of `output`, and leave it out of error messages. Default: `false`.",
+			},
+			"exit_code": schema.Int64Attribute{
+				Computed:      true,
+				Description:   "Exit code of the command, or null when it was not reported.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"output": schema.StringAttribute{
+				Computed:    true,
+				Description: "Output of the command, unless `sensitive` is enabled.",
+			},
+			"sensitive_output": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Output of the command when `sensitive` is enabled.",
+			},
+		},
+	}
+}
+
+func (r *applicationCommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
+}
+
+func (r *applicationCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationCommandResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := plan.ApplicationUuid.ValueString()
+	tflog.Debug(ctx, "Running application command", map[string]interface{}{
+		"application_uuid": uuid,
+	})
+
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	cmdCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var execDiags diag.Diagnostics
+	output, ok := executeApplicationCommand(cmdCtx, r.client, &execDiags, uuid, withExitCode(plan.Command.ValueString()))
+	if !ok {
+		if errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Command timed out in application: uuid=%s", uuid),
+				fmt.Sprintf("The command did not finish within %s. Coolify does not stop the command, so it may still be running in the container.", timeout),
+			)
+			return
+		}
+		resp.Diagnostics.Append(execDiags...)
+		return
+	}
+
+	output, exitCode, ok := splitExitCode(output)
+	data := plan
+	data.ExitCode = types.Int64Value(exitCode)
+	if !ok {
+		data.ExitCode = types.Int64Null()
+	}
+	data.setOutput(output)
+
+	// The state is saved even when the command fails, so the output can be inspected and the resource is tainted
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	var details string
+	switch {
+	case !ok:
+		details = "The exit code of the command was not reported, so it is unknown whether the command succeeded."
+	case exitCode != 0:
+		details = fmt.Sprintf("The command exited with code %d.", exitCode)
+	default:
+		return
+	}
+	if !plan.Sensitive.ValueBool() {
+		details += " Output:\n" + output
+	}
+	resp.Diagnostics.AddError(fmt.Sprintf("Command failed in application: uuid=%s", uuid), details)
+}
+
+func (r *applicationCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The result of a command is only known when it runs, so the state is kept as is
+}
+
+func (r *applicationCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan applicationCommandResourceModel
+	var state applicationCommandResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only `timeout` and `sensitive` can change without replacement, so the command does not run again
+	output := state.Output.ValueString()
+	if state.Sensitive.ValueBool() {
+		output = state.SensitiveOutput.ValueString()
+	}
+	data := plan
+	data.ExitCode = state.ExitCode
+	data.setOutput(output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *applicationCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state applicationCommandResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A command that ran cannot be undone
+	tflog.Debug(ctx, "Removing application command from state", map[string]interface{}{
+		"application_uuid": state.ApplicationUuid.ValueString(),
+	})
+}
+
+// MARK: Helper functions
+
+// setOutput stores the output in the attribute matching the sensitivity of the resource.
+func (m *applicationCommandResourceModel) setOutput(output string) {
+	if m.Sensitive.ValueBool() {
+		m.Output = types.StringNull()
+		m.SensitiveOutput = types.StringValue(output)
+	} else {
+		m.Output = types.StringValue(output)
+		m.SensitiveOutput = types.StringNull()
+	}
+}
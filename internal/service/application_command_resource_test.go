@@ -0,0 +1,80 @@
+package service_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestAccApplicationCommandResource(t *testing.T) {
+	resName := "coolify_application_command.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "coolify_application_command" "test" {
+					application_uuid = "` + acctest.ApplicationUUID + `"
+					command          = "echo hello"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "exit_code", "0"),
+					resource.TestCheckResourceAttr(resName, "output", "hello"),
+					resource.TestCheckNoResourceAttr(resName, "sensitive_output"),
+				),
+			},
+			{
+				Config: `
+				resource "coolify_application_command" "test" {
+					application_uuid = "` + acctest.ApplicationUUID + `"
+					command          = "echo hello"
+					sensitive        = true
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "exit_code", "0"),
+					resource.TestCheckNoResourceAttr(resName, "output"),
+					resource.TestCheckResourceAttr(resName, "sensitive_output", "hello"),
+				),
+			},
+			{
+				Config: `
+				resource "coolify_application_command" "test" {
+					application_uuid = "` + acctest.ApplicationUUID + `"
+					command          = "exit 3"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`The command exited with code 3`),
+			},
+		},
+	})
+}
+
+func TestApplicationCommandResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	rs := service.NewApplicationCommandResource()
+	resp := &tfresource.SchemaResponse{}
+	rs.Schema(ctx, tfresource.SchemaRequest{}, resp)
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation failed: %v", diags)
+	}
+
+	for _, attr := range []string{"application_uuid", "command", "triggers", "timeout", "sensitive", "exit_code", "output", "sensitive_output"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("attribute %q should exist in schema", attr)
+		}
+	}
+
+	if !resp.Schema.Attributes["sensitive_output"].IsSensitive() {
+		t.Error("sensitive_output should be sensitive")
+	}
+}
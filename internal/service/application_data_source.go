@@ -4,11 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
 	"terraform-provider-coolify/internal/flatten"
 	"terraform-provider-coolify/internal/provider/generated/datasource_application"
 	"terraform-provider-coolify/internal/provider/util"
@@ -16,6 +25,7 @@ import (
 
 var _ datasource.DataSource = &applicationDataSource{}
 var _ datasource.DataSourceWithConfigure = &applicationDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &applicationDataSource{}
 
 func NewApplicationDataSource() datasource.DataSource {
 	return &applicationDataSource{}
@@ -25,13 +35,35 @@ type applicationDataSource struct {
 	client *api.ClientWithResponses
 }
 
+type applicationDataSourceModel struct {
+	datasource_application.ApplicationModel
+	ProjectUuid     types.String `tfsdk:"project_uuid"`
+	EnvironmentName types.String `tfsdk:"environment_name"`
+}
+
 func (d *applicationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application"
 }
 
 func (d *applicationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = datasource_application.ApplicationDataSourceSchema(ctx)
-	resp.Schema.Description = "Get a Coolify application by `uuid`."
+	resp.Schema.Description = "Get a Coolify application by exactly one of `uuid`, `name` or `fqdn`." +
+		"\nA `name` lookup can be narrowed to an environment with `project_uuid` and `environment_name`." +
+		" A `fqdn` lookup matches any of the domains of the application, with or without scheme, ie `app.example.com` matches `https://app.example.com`."
+
+	for _, attr := range []string{"uuid", "name", "fqdn"} {
+		makeDataSourceAttributeLookup(resp.Schema.Attributes, attr)
+	}
+	resp.Schema.Attributes["project_uuid"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "UUID of the project to look up the application `name` in. Requires `environment_name`.",
+		Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("name"))},
+	}
+	resp.Schema.Attributes["environment_name"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Name of the environment to look up the application `name` in. Requires `project_uuid`.",
+		Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("name"))},
+	}
 
 	// Mark sensitive attributes
 	sensitiveAttrs := []string{"manual_webhook_secret_bitbucket", "manual_webhook_secret_gitea", "manual_webhook_secret_github", "manual_webhook_secret_gitlab"}
@@ -40,12 +72,26 @@ func (d *applicationDataSource) Schema(ctx context.Context, req datasource.Schem
 	}
 }
 
+func (d *applicationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uuid"),
+			path.MatchRoot("name"),
+			path.MatchRoot("fqdn"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("project_uuid"),
+			path.MatchRoot("environment_name"),
+		),
+	}
+}
+
 func (d *applicationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	util.ProviderDataFromDataSourceConfigureRequest(req, &d.client, resp)
 }
 
 func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan datasource_application.ApplicationModel
+	var plan applicationDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
@@ -54,7 +100,15 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	applicationResp, err := d.client.GetApplicationByUuidWithResponse(ctx, plan.Uuid.ValueString())
+	uuid := plan.Uuid.ValueString()
+	if plan.Uuid.IsNull() {
+		uuid = d.lookupUuid(ctx, &resp.Diagnostics, plan)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	applicationResp, err := d.client.GetApplicationByUuidWithResponse(ctx, uuid)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading application", err.Error(),
@@ -63,18 +117,103 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	if applicationResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading application",
-			fmt.Sprintf("Received %s for application. Details: %s", applicationResp.Status(), string(applicationResp.Body)),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading application", applicationResp.HTTPResponse, applicationResp.Body, nil)
 		return
 	}
 
-	state := d.ApiToModel(ctx, &resp.Diagnostics, applicationResp.JSON200)
+	state := applicationDataSourceModel{
+		ApplicationModel: d.ApiToModel(ctx, &resp.Diagnostics, applicationResp.JSON200),
+		ProjectUuid:      plan.ProjectUuid,
+		EnvironmentName:  plan.EnvironmentName,
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// lookupUuid finds the UUID of the only application matching the name or domain.
+func (d *applicationDataSource) lookupUuid(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	plan applicationDataSourceModel,
+) string {
+	lookupValues := map[string]attr.Value{
+		"name": plan.Name,
+		"fqdn": plan.Fqdn,
+	}
+
+	environmentId := types.Int64Null()
+	if !plan.EnvironmentName.IsNull() {
+		projectUuid, environmentName := plan.ProjectUuid.ValueString(), plan.EnvironmentName.ValueString()
+
+		envResp, err := d.client.GetEnvironmentByNameOrUuidWithResponse(ctx, projectUuid, environmentName)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error reading project environment: uuid=%s, environment=%s", projectUuid, environmentName),
+				err.Error(),
+			)
+			return ""
+		}
+
+		if envResp.StatusCode() != http.StatusOK {
+			util.AddAPIError(diags, fmt.Sprintf("reading project environment: uuid=%s, environment=%s", projectUuid, environmentName), envResp.HTTPResponse, envResp.Body, nil)
+			return ""
+		}
+
+		environmentId = flatten.Int64(envResp.JSON200.Id)
+		lookupValues["project_uuid"] = plan.ProjectUuid
+		lookupValues["environment_name"] = plan.EnvironmentName
+	}
+
+	listResp, err := d.client.ListApplicationsWithResponse(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading applications", err.Error(),
+		)
+		return ""
+	}
+
+	if listResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, "reading applications", listResp.HTTPResponse, listResp.Body, nil)
+		return ""
+	}
+
+	lookup := filter.FromLookup(lookupValues)
+	application, ok := filter.SelectOne(diags, "application", lookup, *listResp.JSON200, func(item api.Application) bool {
+		if !environmentId.IsNull() && !environmentId.Equal(flatten.Int64(item.EnvironmentId)) {
+			return false
+		}
+		if !plan.Fqdn.IsNull() && !applicationHasDomain(flatten.String(item.Fqdn).ValueString(), plan.Fqdn.ValueString()) {
+			return false
+		}
+		return plan.Name.IsNull() || plan.Name.Equal(flatten.String(item.Name))
+	})
+	if !ok {
+		return ""
+	}
+	return flatten.String(application.Uuid).ValueString()
+}
+
+// applicationHasDomain reports whether the domain is one of the comma separated application domains.
+// The scheme and a trailing slash are ignored on both sides, so `app.example.com` matches `https://app.example.com/`.
+func applicationHasDomain(fqdn string, domain string) bool {
+	domain = normalizeDomain(domain)
+	for _, d := range strings.Split(fqdn, ",") {
+		if normalizeDomain(d) == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeDomain strips the scheme and a trailing slash, and lowercases the domain.
+func normalizeDomain(domain string) string {
+	domain = strings.TrimSpace(domain)
+	if _, rest, ok := strings.Cut(domain, "://"); ok {
+		domain = rest
+	}
+	return strings.ToLower(strings.TrimSuffix(domain, "/"))
+}
+
 func (d *applicationDataSource) ApiToModel(
 	ctx context.Context,
 	diags *diag.Diagnostics,
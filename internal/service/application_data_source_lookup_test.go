@@ -0,0 +1,33 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationHasDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		fqdn     string
+		domain   string
+		expected bool
+	}{
+		{"exact", "https://app.example.com", "https://app.example.com", true},
+		{"domain without scheme", "https://app.example.com", "app.example.com", true},
+		{"fqdn without scheme", "app.example.com", "http://app.example.com", true},
+		{"trailing slash", "https://app.example.com/", "app.example.com", true},
+		{"case", "https://App.Example.com", "app.example.com/", true},
+		{"one of several", "https://www.example.com, https://app.example.com", "app.example.com", true},
+		{"port", "https://app.example.com:8080", "app.example.com:8080", true},
+		{"other domain", "https://app.example.com", "example.com", false},
+		{"other port", "https://app.example.com:8080", "app.example.com", false},
+		{"empty", "", "app.example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, applicationHasDomain(tt.fqdn, tt.domain))
+		})
+	}
+}
@@ -2,10 +2,12 @@ package service_test
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 
 	"terraform-provider-coolify/internal/acctest"
 	"terraform-provider-coolify/internal/service"
@@ -29,6 +31,21 @@ func TestAccApplicationDataSource(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resName, "description"),
 				),
 			},
+			{ // Lookup by name
+				Config: `data "coolify_application" "test" {
+					name = "dockerfile-` + acctest.ApplicationUUID + `"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "uuid", acctest.ApplicationUUID),
+				),
+			},
+			{ // Environment without a project
+				Config: `data "coolify_application" "test" {
+					name             = "dockerfile-` + acctest.ApplicationUUID + `"
+					environment_name = "production"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
 		},
 	})
 }
@@ -38,4 +55,11 @@ func TestApplicationDataSourceSchema(t *testing.T) {
 	ds := service.NewApplicationDataSource()
 	resp := &datasource.SchemaResponse{}
 	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	for _, attr := range []string{"uuid", "name", "fqdn"} {
+		assert.True(t, resp.Schema.Attributes[attr].IsOptional(), "%s should be optional", attr)
+		assert.True(t, resp.Schema.Attributes[attr].IsComputed(), "%s should be computed", attr)
+	}
+	assert.Contains(t, resp.Schema.Attributes, "project_uuid")
+	assert.Contains(t, resp.Schema.Attributes, "environment_name")
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = &applicationEnvsResource{}
 	_ resource.ResourceWithConfigure   = &applicationEnvsResource{}
 	_ resource.ResourceWithImportState = &applicationEnvsResource{}
+	_ resource.ResourceWithIdentity    = &applicationEnvsResource{}
 )
 
 func NewApplicationEnvsResource() resource.Resource {
@@ -83,6 +85,10 @@ func (r *applicationEnvsResource) Schema(ctx context.Context, req resource.Schem
 	makeResourceAttributeRequired(codegenSchema.Attributes, "value")
 }
 
+func (r *applicationEnvsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("application_uuid", "UUID of the application.")
+}
+
 func (r *applicationEnvsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
 }
@@ -118,10 +124,8 @@ func (r *applicationEnvsResource) Create(ctx context.Context, req resource.Creat
 		}
 
 		if createResp.StatusCode() != http.StatusCreated {
-			resp.Diagnostics.AddError(
-				"Unexpected HTTP status code creating application envs",
-				fmt.Sprintf("Received %s creating application envs. Details: %s", createResp.Status(), createResp.Body),
-			)
+			util.AddAPIErrorAt(&resp.Diagnostics, "creating application envs", createResp.HTTPResponse, createResp.Body,
+				util.AttributePaths(env, "", path.Root("env").AtListIndex(i)))
 			return
 		}
 
@@ -133,6 +137,7 @@ func (r *applicationEnvsResource) Create(ctx context.Context, req resource.Creat
 	data.Env = r.filterRelevantEnvs(plan.Env, data.Env)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationIdentityModel{ApplicationUuid: data.Uuid})...)
 }
 
 func (r *applicationEnvsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -152,10 +157,14 @@ func (r *applicationEnvsResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	data := r.readFromAPI(ctx, &resp.Diagnostics, state.Uuid.ValueString())
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
 	if len(state.Env) > 0 {
 		data.Env = r.filterRelevantEnvs(state.Env, data.Env)
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationIdentityModel{ApplicationUuid: data.Uuid})...)
 }
 
 func (r *applicationEnvsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -208,7 +217,10 @@ func (r *applicationEnvsResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	var bulkUpdateEnvs = []updateEnvsByApplicationUuidJSONRequestBodyItem{}
-	for _, env := range plan.Env {
+	// Validation errors of the bulk update are reported per item, ie `data.0.key`
+	errorPaths := map[string]path.Path{}
+	for i, env := range plan.Env {
+		maps.Copy(errorPaths, util.AttributePaths(env, fmt.Sprintf("data.%d.", i), path.Root("env").AtListIndex(i)))
 		bulkUpdateEnvs = append(bulkUpdateEnvs, updateEnvsByApplicationUuidJSONRequestBodyItem{
 			IsBuildTime: env.IsBuildTime.ValueBoolPointer(),
 			IsLiteral:   env.IsLiteral.ValueBoolPointer(),
@@ -234,9 +246,7 @@ func (r *applicationEnvsResource) Update(ctx context.Context, req resource.Updat
 		}
 
 		if updateResp.StatusCode() != http.StatusCreated {
-			resp.Diagnostics.AddError(
-				"Unexpected HTTP status code updating application envs",
-				fmt.Sprintf("Received %s updating application envs: uuid=%s. Details: %s", updateResp.Status(), uuid, updateResp.Body))
+			util.AddAPIErrorAt(&resp.Diagnostics, fmt.Sprintf("updating application envs: uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, errorPaths)
 			return
 		}
 	}
@@ -244,6 +254,7 @@ func (r *applicationEnvsResource) Update(ctx context.Context, req resource.Updat
 	data := r.readFromAPI(ctx, &resp.Diagnostics, uuid)
 	data.Env = r.filterRelevantEnvs(plan.Env, data.Env)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationIdentityModel{ApplicationUuid: data.Uuid})...)
 }
 
 func (r *applicationEnvsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -265,7 +276,7 @@ func (r *applicationEnvsResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *applicationEnvsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("uuid"), path.Root("application_uuid"), req, resp)
 }
 
 // MARK: Helper Functions
@@ -318,9 +329,7 @@ func (r *applicationEnvsResource) readFromAPI(
 	}
 
 	if readResp.StatusCode() != http.StatusOK {
-		diags.AddError(
-			"Unexpected HTTP status code reading application envs",
-			fmt.Sprintf("Received %s for application envs: uuid=%s. Details: %s", readResp.Status(), uuid, readResp.Body))
+		util.AddAPIError(diags, fmt.Sprintf("reading application envs: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
 		return applicationEnvsResourceModel{}
 	}
 
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/flatten"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ action.Action              = &applicationExecuteCommandAction{}
+	_ action.ActionWithConfigure = &applicationExecuteCommandAction{}
+)
+
+type applicationExecuteCommandAction struct {
+	client *api.ClientWithResponses
+}
+
+type applicationExecuteCommandActionModel struct {
+	Uuid    types.String `tfsdk:"uuid"`
+	Command types.String `tfsdk:"command"`
+}
+
+func NewApplicationExecuteCommandAction() action.Action {
+	return &applicationExecuteCommandAction{}
+}
+
+func (a *applicationExecuteCommandAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_execute_command"
+}
+
+func (a *applicationExecuteCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Executes a command in the container of a Coolify application.",
+		MarkdownDescription: "Executes a command in the container of a Coolify application. The output of the command is reported once it is done.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "UUID of the application.",
+			},
+			"command": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+				Description: "Command to execute.",
+			},
+		},
+	}
+}
+
+func (a *applicationExecuteCommandAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	util.ProviderDataFromActionConfigureRequest(req, &a.client, resp)
+}
+
+func (a *applicationExecuteCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config applicationExecuteCommandActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	progress := actionProgress(ctx, resp)
+	uuid := config.Uuid.ValueString()
+
+	progress(fmt.Sprintf("Executing command in application %s", uuid))
+	output, ok := executeApplicationCommand(ctx, a.client, &resp.Diagnostics, uuid, config.Command.ValueString())
+	if !ok {
+		return
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		progress(line)
+	}
+}
+
+// executeApplicationCommand executes a command in the container of an application and returns its output.
+func executeApplicationCommand(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	uuid string,
+	command string,
+) (string, bool) {
+	execResp, err := client.ExecuteCommandApplicationWithResponse(ctx, uuid, api.ExecuteCommandApplicationJSONRequestBody{
+		Command: &command,
+	})
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error executing command in application: uuid=%s", uuid),
+			err.Error(),
+		)
+		return "", false
+	}
+
+	if execResp.StatusCode() != http.StatusOK || execResp.JSON200 == nil {
+		util.AddAPIError(diags, fmt.Sprintf("executing command in application: uuid=%s", uuid), execResp.HTTPResponse, execResp.Body, nil)
+		return "", false
+	}
+
+	return flatten.String(execResp.JSON200.Response).ValueString(), true
+}
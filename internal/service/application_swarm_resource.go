@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/expand"
+	"terraform-provider-coolify/internal/flatten"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ resource.Resource                = &applicationSwarmResource{}
+	_ resource.ResourceWithConfigure   = &applicationSwarmResource{}
+	_ resource.ResourceWithImportState = &applicationSwarmResource{}
+	_ resource.ResourceWithIdentity    = &applicationSwarmResource{}
+	_ resource.ResourceWithModifyPlan  = &applicationSwarmResource{}
+)
+
+func NewApplicationSwarmResource() resource.Resource {
+	return &applicationSwarmResource{}
+}
+
+type applicationSwarmResource struct {
+	client *api.ClientWithResponses
+}
+
+type applicationSwarmResourceModel struct {
+	ApplicationUuid      types.String `tfsdk:"application_uuid"`
+	Replicas             types.Int64  `tfsdk:"replicas"`
+	PlacementConstraints types.String `tfsdk:"placement_constraints"`
+}
+
+func (r *applicationSwarmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_swarm"
+}
+
+func (r *applicationSwarmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the Docker Swarm settings of a Coolify application." +
+			"\n**NOTE:** The application must be deployed to a server that is a swarm manager, which is checked during plan," +
+			" or when the swarm settings are applied if the application is not known yet." +
+			" Enable `is_swarm_manager` in `coolify_server_settings` in an earlier apply." +
+			" Destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"application_uuid": schema.StringAttribute{
+				Required:      true,
+				Description:   "UUID of the application.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"replicas": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Number of replicas of the application in the swarm.",
+				Validators:    []validator.Int64{int64validator.AtLeast(1)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"placement_constraints": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Swarm placement constraints in YAML format, ie `placement:\\n  constraints:\\n    - 'node.role == worker'`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *applicationSwarmResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("application_uuid", "UUID of the application.")
+}
+
+func (r *applicationSwarmResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
+}
+
+func (r *applicationSwarmResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan applicationSwarmResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Checked on apply instead
+	if plan.ApplicationUuid.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state applicationSwarmResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !swarmSettingsChanged(plan, state) {
+			return
+		}
+	}
+
+	r.validateSwarmManager(ctx, &resp.Diagnostics, plan.ApplicationUuid.ValueString())
+}
+
+func (r *applicationSwarmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationSwarmResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Checked again, as the application may not have been known during plan
+	r.validateSwarmManager(ctx, &resp.Diagnostics, plan.ApplicationUuid.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateSwarm(ctx, &resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, plan.ApplicationUuid.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationIdentityModel{ApplicationUuid: data.ApplicationUuid})...)
+}
+
+func (r *applicationSwarmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applicationSwarmResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading application swarm settings", map[string]interface{}{
+		"application_uuid": state.ApplicationUuid.ValueString(),
+	})
+	if state.ApplicationUuid.ValueString() == "" {
+		resp.Diagnostics.AddError("Invalid State", "No application UUID found in state")
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, state.ApplicationUuid.ValueString())
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationIdentityModel{ApplicationUuid: data.ApplicationUuid})...)
+}
+
+func (r *applicationSwarmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state applicationSwarmResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Checked again, as the application may not have been known during plan
+	if swarmSettingsChanged(plan, state) {
+		r.validateSwarmManager(ctx, &resp.Diagnostics, plan.ApplicationUuid.ValueString())
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.updateSwarm(ctx, &resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, plan.ApplicationUuid.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationIdentityModel{ApplicationUuid: data.ApplicationUuid})...)
+}
+
+func (r *applicationSwarmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state applicationSwarmResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Swarm settings are part of the application and cannot be deleted
+	tflog.Debug(ctx, "Removing application swarm settings from state", map[string]interface{}{
+		"application_uuid": state.ApplicationUuid.ValueString(),
+	})
+}
+
+func (r *applicationSwarmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("application_uuid"), path.Root("application_uuid"), req, resp)
+}
+
+// MARK: Helper functions
+
+// swarmSettingsChanged reports whether the plan changes the swarm settings.
+// The destination server is only checked when they change.
+func swarmSettingsChanged(plan, state applicationSwarmResourceModel) bool {
+	return !plan.Replicas.Equal(state.Replicas) || !plan.PlacementConstraints.Equal(state.PlacementConstraints)
+}
+
+// validateSwarmManager checks that the application is deployed to a server that is a swarm manager.
+// Only the resources of swarm managers are listed, as the application has no reference to its server.
+func (r *applicationSwarmResource) validateSwarmManager(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	applicationUuid string,
+) {
+	listResp, err := r.client.ListServersWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading servers", err.Error())
+		return
+	}
+
+	if listResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, "reading servers", listResp.HTTPResponse, listResp.Body, nil)
+		return
+	}
+
+	for _, server := range *listResp.JSON200 {
+		if server.Uuid == nil || server.Settings == nil || !flatten.Bool(server.Settings.IsSwarmManager).ValueBool() {
+			continue
+		}
+
+		resourcesResp, err := r.client.GetResourcesByServerUuidWithResponse(ctx, *server.Uuid)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error reading server resources: uuid=%s", *server.Uuid),
+				err.Error(),
+			)
+			return
+		}
+
+		if resourcesResp.StatusCode() != http.StatusOK {
+			util.AddAPIError(diags, fmt.Sprintf("reading server resources: uuid=%s", *server.Uuid), resourcesResp.HTTPResponse, resourcesResp.Body, nil)
+			return
+		}
+
+		for _, res := range *resourcesResp.JSON200 {
+			if res.Uuid != nil && *res.Uuid == applicationUuid {
+				return
+			}
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("application_uuid"),
+		"Application is not deployed to a swarm manager",
+		fmt.Sprintf("Swarm settings can only be used on applications deployed to a swarm manager,"+
+			" but no swarm manager runs the application: application_uuid=%s."+
+			" Set `is_swarm_manager` in `coolify_server_settings` for its server.", applicationUuid),
+	)
+}
+
+func (r *applicationSwarmResource) updateSwarm(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	plan applicationSwarmResourceModel,
+) {
+	uuid := plan.ApplicationUuid.ValueString()
+
+	tflog.Debug(ctx, "Updating application swarm settings", map[string]interface{}{
+		"application_uuid": uuid,
+	})
+	updateResp, err := r.client.UpdateApplicationByUuidWithResponse(ctx, uuid, api.UpdateApplicationByUuidJSONRequestBody{
+		SwarmReplicas:             expand.Int64(plan.Replicas),
+		SwarmPlacementConstraints: base64EncodeAttr(plan.PlacementConstraints),
+	})
+
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error updating application swarm settings: application_uuid=%s", uuid),
+			err.Error(),
+		)
+		return
+	}
+
+	if updateResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, fmt.Sprintf("updating application swarm settings: application_uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, plan)
+		return
+	}
+}
+
+func (r *applicationSwarmResource) ReadFromAPI(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	uuid string,
+) applicationSwarmResourceModel {
+	readResp, err := r.client.GetApplicationByUuidWithResponse(ctx, uuid)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading application swarm settings: application_uuid=%s", uuid),
+			err.Error(),
+		)
+		return applicationSwarmResourceModel{}
+	}
+
+	if readResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, fmt.Sprintf("reading application swarm settings: application_uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
+		return applicationSwarmResourceModel{}
+	}
+
+	return applicationSwarmResourceModel{}.FromAPI(uuid, readResp.JSON200)
+}
+
+func (m applicationSwarmResourceModel) FromAPI(applicationUuid string, application *api.Application) applicationSwarmResourceModel {
+	return applicationSwarmResourceModel{
+		ApplicationUuid:      types.StringValue(applicationUuid),
+		Replicas:             flatten.Int64(application.SwarmReplicas),
+		PlacementConstraints: flatten.String(base64Decode(application.SwarmPlacementConstraints)),
+	}
+}
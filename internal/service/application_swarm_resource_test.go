@@ -0,0 +1,49 @@
+package service_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestAccApplicationSwarmResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The test server is not a swarm manager, which is checked during plan
+				PlanOnly: true,
+				Config: `
+				resource "coolify_application_swarm" "test" {
+					application_uuid = "` + acctest.ApplicationUUID + `"
+					replicas         = 2
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Application is not deployed to a swarm manager`),
+			},
+		},
+	})
+}
+
+func TestApplicationSwarmResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	rs := service.NewApplicationSwarmResource()
+	resp := &tfresource.SchemaResponse{}
+	rs.Schema(ctx, tfresource.SchemaRequest{}, resp)
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation failed: %v", diags)
+	}
+
+	for _, attr := range []string{"application_uuid", "replicas", "placement_constraints"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("attribute %q should exist in schema", attr)
+		}
+	}
+}
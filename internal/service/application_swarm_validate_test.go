@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestValidateSwarmManager(t *testing.T) {
+	// Server "manager" is a swarm manager running app "a1", server "worker" runs app "a2"
+	var mu sync.Mutex
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requested = append(requested, r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/servers":
+			fmt.Fprint(w, `[
+				{"uuid": "worker", "name": "worker", "settings": {"is_swarm_manager": false}},
+				{"uuid": "manager", "name": "manager", "settings": {"is_swarm_manager": true}}
+			]`)
+		case "/servers/manager/resources":
+			fmt.Fprint(w, `[{"uuid": "a1", "type": "application"}]`)
+		case "/servers/worker/resources":
+			fmt.Fprint(w, `[{"uuid": "a2", "type": "application"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+	require.NoError(t, err)
+	r := &applicationSwarmResource{client: client}
+
+	t.Run("deployed to a swarm manager", func(t *testing.T) {
+		requested = nil
+		var diags diag.Diagnostics
+		r.validateSwarmManager(context.Background(), &diags, "a1")
+
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, []string{"/servers", "/servers/manager/resources"}, requested, "only swarm managers are listed")
+	})
+
+	t.Run("not deployed to a swarm manager", func(t *testing.T) {
+		requested = nil
+		var diags diag.Diagnostics
+		r.validateSwarmManager(context.Background(), &diags, "a2")
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Application is not deployed to a swarm manager", diags.Errors()[0].Summary())
+		assert.Equal(t, []string{"/servers", "/servers/manager/resources"}, requested)
+	})
+
+	t.Run("checked during plan", func(t *testing.T) {
+		requested = nil
+		resp := modifySwarmPlan(t, r, tftypes.NewValue(tftypes.String, "a2"))
+
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Application is not deployed to a swarm manager", resp.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("unknown application is checked on apply", func(t *testing.T) {
+		requested = nil
+		resp := modifySwarmPlan(t, r, tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Empty(t, requested)
+	})
+}
+
+// modifySwarmPlan plans the creation of swarm settings for the application.
+func modifySwarmPlan(t *testing.T, r *applicationSwarmResource, applicationUuid tftypes.Value) *resource.ModifyPlanResponse {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"application_uuid":      applicationUuid,
+		"replicas":              tftypes.NewValue(tftypes.Number, 2),
+		"placement_constraints": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}, resp)
+	return resp
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -30,11 +29,12 @@ type applicationsDataSource struct {
 }
 
 type applicationsDataSourceWithFilterModel struct {
-	datasource_applications.ApplicationsModel
-	Filter []filter.BlockModel `tfsdk:"filter"`
+	Applications types.List          `tfsdk:"applications"`
+	Filter       []filter.BlockModel `tfsdk:"filter"`
+	filter.SortModel
 }
 
-var applicationsFilterNames = []string{"id", "uuid", "name", "description", "fqdn"}
+var applicationsMostRecentField = "created_at"
 
 func (d *applicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_applications"
@@ -43,9 +43,6 @@ func (d *applicationsDataSource) Metadata(ctx context.Context, req datasource.Me
 func (d *applicationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = datasource_applications.ApplicationsDataSourceSchema(ctx)
 	resp.Schema.Description = "Get a list of Coolify applications."
-	resp.Schema.Blocks = map[string]schema.Block{
-		"filter": filter.CreateDatasourceFilter(applicationsFilterNames),
-	}
 
 	// Mark sensitive attributes
 	sensitiveAttrs := []string{"manual_webhook_secret_bitbucket", "manual_webhook_secret_gitea", "manual_webhook_secret_github", "manual_webhook_secret_gitlab"}
@@ -55,6 +52,8 @@ func (d *applicationsDataSource) Schema(ctx context.Context, req datasource.Sche
 			attr,
 		)
 	}
+
+	makeDataSourceListFilterable(&resp.Schema, "applications", applicationsMostRecentField)
 }
 
 func (d *applicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -80,14 +79,11 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	if listResponse.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading applications",
-			fmt.Sprintf("Received %s for applications. Details: %s", listResponse.Status(), string(listResponse.Body)),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading applications", listResponse.HTTPResponse, listResponse.Body, nil)
 		return
 	}
 
-	state, diag := d.ApiToModel(ctx, listResponse.JSON200, plan.Filter)
+	state, diag := d.ApiToModel(ctx, listResponse.JSON200, plan.Filter, plan.SortModel)
 	resp.Diagnostics.Append(diag...)
 
 	if resp.Diagnostics.HasError() {
@@ -101,10 +97,12 @@ func (d *applicationsDataSource) ApiToModel(
 	ctx context.Context,
 	response *[]api.Application,
 	filters []filter.BlockModel,
+	sort filter.SortModel,
 ) (applicationsDataSourceWithFilterModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var applications []attr.Value
+	var applications []map[string]attr.Value
 
+	matcher := filter.Compile(ctx, filters)
 	for _, application := range *response {
 		attributes := map[string]attr.Value{
 			"base_directory":                      flatten.String(application.BaseDirectory),
@@ -186,24 +184,28 @@ func (d *applicationsDataSource) ApiToModel(
 			"watch_paths":                         flatten.String(application.WatchPaths),
 		}
 
-		if !filter.OnAttributes(attributes, filters) {
+		if !matcher.OnAttributes(attributes) {
 			continue
 		}
 
+		applications = append(applications, attributes)
+	}
+
+	var elements []attr.Value
+	for _, attributes := range filter.Sort(applications, filter.Identity, sort, applicationsMostRecentField) {
 		data, diag := datasource_applications.NewApplicationsValue(
 			datasource_applications.ApplicationsValue{}.AttributeTypes(ctx),
 			attributes)
 		diags.Append(diag...)
-		applications = append(applications, data)
+		elements = append(elements, data)
 	}
 
-	dataSet, diag := types.SetValue(datasource_applications.ApplicationsValue{}.Type(ctx), applications)
+	dataList, diag := types.ListValue(datasource_applications.ApplicationsValue{}.Type(ctx), elements)
 	diags.Append(diag...)
 
 	return applicationsDataSourceWithFilterModel{
-		ApplicationsModel: datasource_applications.ApplicationsModel{
-			Applications: dataSet,
-		},
-		Filter: filters,
+		Applications: dataList,
+		Filter:       filters,
+		SortModel:    sort,
 	}, diags
 }
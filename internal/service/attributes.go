@@ -1,14 +1,20 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+
+	"terraform-provider-coolify/internal/filter"
 )
 
 func makeResourceAttributeRequired(
@@ -83,6 +89,98 @@ func makeDataSourceAttributeSensitive(
 	return nil
 }
 
+// makeDataSourceAttributeLookup makes an attribute usable to look up a data source, while still being read from the API.
+func makeDataSourceAttributeLookup(
+	attributes map[string]datasource_schema.Attribute,
+	attrName string,
+) error {
+	attr, ok := attributes[attrName]
+	if !ok {
+		return fmt.Errorf("attribute %s not found", attrName)
+	}
+
+	switch typedAttr := attr.(type) {
+	case datasource_schema.StringAttribute:
+		typedAttr.Required = false
+		typedAttr.Optional = true
+		typedAttr.Computed = true
+		attributes[attrName] = typedAttr
+	default:
+		return fmt.Errorf("unsupported attribute type for %s", attrName)
+	}
+
+	return nil
+}
+
+// makeDataSourceAttributeOrdered turns a set of nested objects into a list, so results keep the order they are sorted in.
+func makeDataSourceAttributeOrdered(
+	attributes map[string]datasource_schema.Attribute,
+	attrName string,
+) error {
+	attr, ok := attributes[attrName]
+	if !ok {
+		return fmt.Errorf("attribute %s not found", attrName)
+	}
+
+	switch typedAttr := attr.(type) {
+	case datasource_schema.SetNestedAttribute:
+		attributes[attrName] = datasource_schema.ListNestedAttribute{
+			NestedObject:        typedAttr.NestedObject,
+			Required:            typedAttr.Required,
+			Optional:            typedAttr.Optional,
+			Computed:            typedAttr.Computed,
+			Sensitive:           typedAttr.Sensitive,
+			Description:         typedAttr.Description,
+			MarkdownDescription: typedAttr.MarkdownDescription,
+			DeprecationMessage:  typedAttr.DeprecationMessage,
+		}
+	case datasource_schema.ListNestedAttribute:
+	default:
+		return fmt.Errorf("unsupported attribute type for %s", attrName)
+	}
+
+	return nil
+}
+
+// makeDataSourceListFilterable adds the filter block and the sorting attributes to a list data source.
+// The filter names are derived from the attributes of the listed objects.
+func makeDataSourceListFilterable(
+	s *datasource_schema.Schema,
+	attrName string,
+	mostRecentField string,
+) error {
+	if err := makeDataSourceAttributeOrdered(s.Attributes, attrName); err != nil {
+		return err
+	}
+	filterNames := filter.NamesFromSchema(s.Attributes[attrName].(datasource_schema.ListNestedAttribute).NestedObject.Attributes)
+
+	if s.Blocks == nil {
+		s.Blocks = map[string]datasource_schema.Block{}
+	}
+	s.Blocks["filter"] = filter.CreateDatasourceFilter(filterNames)
+	for name, attr := range filter.CreateDatasourceSort(filterNames, mostRecentField) {
+		s.Attributes[name] = attr
+	}
+
+	return nil
+}
+
+// listResourceSchema creates the schema of a list resource, which is filtered and sorted like the matching list data source.
+func listResourceSchema(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	description string,
+	ds datasource.DataSource,
+	attrName string,
+	mostRecentField string,
+) listschema.Schema {
+	dsResp := datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, &dsResp)
+	filterNames := filter.NamesFromSchema(dsResp.Schema.Attributes[attrName].(datasource_schema.ListNestedAttribute).NestedObject.Attributes)
+
+	return filter.CreateListResourceSchema(diags, description, filterNames, mostRecentField)
+}
+
 func setResourceDefaultValue(attributes map[string]resource_schema.Attribute, attrName string, defaultValue interface{}) error {
 	attr, ok := attributes[attrName]
 	if !ok {
@@ -189,6 +189,58 @@ func TestMakeDataSourceAttributeSensitive(t *testing.T) {
 	}
 }
 
+func TestMakeDataSourceAttributeLookup(t *testing.T) {
+	tests := []struct {
+		name        string
+		attributes  map[string]datasource_schema.Attribute
+		attrName    string
+		expectedErr string
+	}{
+		{
+			name: "attribute not found",
+			attributes: map[string]datasource_schema.Attribute{
+				"existing_attr": datasource_schema.StringAttribute{},
+			},
+			attrName:    "missing_attr",
+			expectedErr: "attribute missing_attr not found",
+		},
+		{
+			name: "unsupported attribute type",
+			attributes: map[string]datasource_schema.Attribute{
+				"unsupported_attr": datasource_schema.Int64Attribute{},
+			},
+			attrName:    "unsupported_attr",
+			expectedErr: "unsupported attribute type for unsupported_attr",
+		},
+		{
+			name: "required string attribute",
+			attributes: map[string]datasource_schema.Attribute{
+				"string_attr": datasource_schema.StringAttribute{Required: true},
+			},
+			attrName:    "string_attr",
+			expectedErr: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := makeDataSourceAttributeLookup(tt.attributes, tt.attrName)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				attr := tt.attributes[tt.attrName]
+				switch typedAttr := attr.(type) {
+				case datasource_schema.StringAttribute:
+					assert.False(t, typedAttr.Required)
+					assert.True(t, typedAttr.Optional)
+					assert.True(t, typedAttr.Computed)
+				}
+			}
+		})
+	}
+}
+
 func TestSetResourceDefaultValue(t *testing.T) {
 	tests := []struct {
 		name         string
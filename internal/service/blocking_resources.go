@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/flatten"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+// blockingResource is a resource that prevents its server or project from being deleted.
+type blockingResource struct {
+	Uuid string
+	Name string
+	Type string
+}
+
+// blockingResourcesPollInterval is the time between checks while waiting for force destroyed resources to be removed.
+var blockingResourcesPollInterval = 5 * time.Second
+
+// environmentResourceKeys maps the resource lists returned for an environment to their resource type, in display order.
+var environmentResourceKeys = []struct {
+	Key  string
+	Type string
+}{
+	{"applications", "application"},
+	{"services", "service"},
+	{"postgresqls", "standalone-postgresql"},
+	{"mysqls", "standalone-mysql"},
+	{"mariadbs", "standalone-mariadb"},
+	{"mongodbs", "standalone-mongodb"},
+	{"redis", "standalone-redis"},
+	{"keydbs", "standalone-keydb"},
+	{"dragonflies", "standalone-dragonfly"},
+	{"clickhouses", "standalone-clickhouse"},
+}
+
+// standaloneDatabaseTypes are the resource types deleted through the database endpoint.
+var standaloneDatabaseTypes = []string{
+	"standalone-postgresql",
+	"standalone-mysql",
+	"standalone-mariadb",
+	"standalone-mongodb",
+	"standalone-redis",
+	"standalone-keydb",
+	"standalone-dragonfly",
+	"standalone-clickhouse",
+}
+
+// forceDestroyTimeoutsBlock is the `timeouts` block of resources that wait for their blocking resources to be deleted.
+func forceDestroyTimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Delete: true,
+		DeleteDescription: fmt.Sprintf(
+			"Time to wait for the resources deleted by `force_destroy` to be removed, ie `10m`. Default: `%ds`.",
+			consts.DEFAULT_FORCE_DESTROY_TIMEOUT,
+		),
+	})
+}
+
+// withForceDestroyTimeouts returns the timeouts, or a null block when they are not set, ie when listing or importing.
+func withForceDestroyTimeouts(value timeouts.Value) timeouts.Value {
+	if value.Object.IsNull() || value.Object.IsUnknown() {
+		return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"delete": types.StringType})}
+	}
+	return value
+}
+
+func formatBlockingResources(resources []blockingResource) string {
+	lines := make([]string, 0, len(resources))
+	for _, res := range resources {
+		lines = append(lines, fmt.Sprintf("  - %s (%s, uuid=%s)", res.Name, res.Type, res.Uuid))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// environmentResourcesFromBody extracts the resources of an environment from a raw environment response.
+// They are not part of the documented API model, so anything unexpected is ignored.
+func environmentResourcesFromBody(body []byte) []blockingResource {
+	var environment map[string]json.RawMessage
+	if err := json.Unmarshal(body, &environment); err != nil {
+		return nil
+	}
+
+	var resources []blockingResource
+	for _, key := range environmentResourceKeys {
+		var items []struct {
+			Uuid string `json:"uuid"`
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(environment[key.Key], &items); err != nil {
+			continue
+		}
+
+		for _, item := range items {
+			resources = append(resources, blockingResource{Uuid: item.Uuid, Name: item.Name, Type: key.Type})
+		}
+	}
+	return resources
+}
+
+func serverBlockingResources(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	uuid string,
+) []blockingResource {
+	resourcesResp, err := client.GetResourcesByServerUuidWithResponse(ctx, uuid)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading server resources: uuid=%s", uuid),
+			err.Error(),
+		)
+		return nil
+	}
+
+	if resourcesResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, fmt.Sprintf("reading server resources: uuid=%s", uuid), resourcesResp.HTTPResponse, resourcesResp.Body, nil)
+		return nil
+	}
+
+	if resourcesResp.JSON200 == nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading server resources: uuid=%s", uuid),
+			"Unable to decode the resources returned by Coolify.",
+		)
+		return nil
+	}
+
+	var resources []blockingResource
+	for _, res := range *resourcesResp.JSON200 {
+		resources = append(resources, blockingResource{
+			Uuid: flatten.String(res.Uuid).ValueString(),
+			Name: flatten.String(res.Name).ValueString(),
+			Type: flatten.String(res.Type).ValueString(),
+		})
+	}
+	return resources
+}
+
+func projectBlockingResources(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	uuid string,
+) []blockingResource {
+	projectResp, err := client.GetProjectByUuidWithResponse(ctx, uuid)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading project: uuid=%s", uuid),
+			err.Error(),
+		)
+		return nil
+	}
+
+	if projectResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, fmt.Sprintf("reading project: uuid=%s", uuid), projectResp.HTTPResponse, projectResp.Body, nil)
+		return nil
+	}
+
+	if projectResp.JSON200 == nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading project: uuid=%s", uuid),
+			"Unable to decode the project returned by Coolify.",
+		)
+		return nil
+	}
+
+	if projectResp.JSON200.Environments == nil {
+		return nil
+	}
+
+	var resources []blockingResource
+	for _, env := range *projectResp.JSON200.Environments {
+		name := flatten.String(env.Name).ValueString()
+
+		envResp, err := client.GetEnvironmentByNameOrUuidWithResponse(ctx, uuid, name)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error reading project environment: uuid=%s, environment=%s", uuid, name),
+				err.Error(),
+			)
+			return nil
+		}
+
+		if envResp.StatusCode() != http.StatusOK {
+			util.AddAPIError(diags, fmt.Sprintf("reading project environment: uuid=%s, environment=%s", uuid, name), envResp.HTTPResponse, envResp.Body, nil)
+			return nil
+		}
+
+		resources = append(resources, environmentResourcesFromBody(envResp.Body)...)
+	}
+	return resources
+}
+
+// deleteBlockingResources deletes the resources, including their volumes and configurations.
+// Coolify removes resources asynchronously, so callers should wait with waitForNoBlockingResources.
+func deleteBlockingResources(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	resources []blockingResource,
+) {
+	deleteConfigurations, deleteVolumes, dockerCleanup, deleteConnectedNetworks := true, true, true, false
+
+	for _, res := range resources {
+		tflog.Debug(ctx, "Force destroying resource", map[string]interface{}{
+			"uuid": res.Uuid,
+			"name": res.Name,
+			"type": res.Type,
+		})
+
+		var httpResp *http.Response
+		var body []byte
+		var err error
+		switch {
+		case res.Type == "application":
+			var deleteResp *api.DeleteApplicationByUuidResponse
+			deleteResp, err = client.DeleteApplicationByUuidWithResponse(ctx, res.Uuid, &api.DeleteApplicationByUuidParams{
+				DeleteConfigurations:    &deleteConfigurations,
+				DeleteVolumes:           &deleteVolumes,
+				DockerCleanup:           &dockerCleanup,
+				DeleteConnectedNetworks: &deleteConnectedNetworks,
+			})
+			if err == nil && deleteResp.JSON200 == nil {
+				httpResp, body = deleteResp.HTTPResponse, deleteResp.Body
+			}
+		case res.Type == "service":
+			var deleteResp *api.DeleteServiceByUuidResponse
+			deleteResp, err = client.DeleteServiceByUuidWithResponse(ctx, res.Uuid, &api.DeleteServiceByUuidParams{
+				DeleteConfigurations:    &deleteConfigurations,
+				DeleteVolumes:           &deleteVolumes,
+				DockerCleanup:           &dockerCleanup,
+				DeleteConnectedNetworks: &deleteConnectedNetworks,
+			})
+			if err == nil && deleteResp.JSON200 == nil {
+				httpResp, body = deleteResp.HTTPResponse, deleteResp.Body
+			}
+		case slices.Contains(standaloneDatabaseTypes, res.Type):
+			var deleteResp *api.DeleteDatabaseByUuidResponse
+			deleteResp, err = client.DeleteDatabaseByUuidWithResponse(ctx, res.Uuid, &api.DeleteDatabaseByUuidParams{
+				DeleteConfigurations:    &deleteConfigurations,
+				DeleteVolumes:           &deleteVolumes,
+				DockerCleanup:           &dockerCleanup,
+				DeleteConnectedNetworks: &deleteConnectedNetworks,
+			})
+			if err == nil && deleteResp.JSON200 == nil {
+				httpResp, body = deleteResp.HTTPResponse, deleteResp.Body
+			}
+		default:
+			// Never guess the endpoint, a UUID could be deleted as the wrong kind of resource
+			diags.AddError(
+				"Unsupported resource type",
+				fmt.Sprintf("Unable to force destroy %s (uuid=%s): resources of type %q can not be deleted by the provider. Delete it in Coolify first.",
+					res.Name, res.Uuid, res.Type),
+			)
+			return
+		}
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s %s, got error: %s", res.Type, res.Name, err))
+			return
+		}
+
+		// A resource that is already gone does not block the deletion
+		if httpResp != nil && httpResp.StatusCode != http.StatusNotFound {
+			util.AddAPIError(diags, fmt.Sprintf("deleting %s: name=%s, uuid=%s", res.Type, res.Name, res.Uuid), httpResp, body, nil)
+			return
+		}
+	}
+}
+
+// waitForNoBlockingResources polls until list returns no resources, or the timeout expires.
+func waitForNoBlockingResources(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	timeout time.Duration,
+	list func(diags *diag.Diagnostics) []blockingResource,
+) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(blockingResourcesPollInterval)
+	defer ticker.Stop()
+
+	for {
+		var listDiags diag.Diagnostics
+		remaining := list(&listDiags)
+		if !listDiags.HasError() && len(remaining) == 0 {
+			return
+		}
+
+		tflog.Debug(ctx, "Waiting for resources to be deleted", map[string]interface{}{
+			"remaining": len(remaining),
+		})
+
+		select {
+		case <-ctx.Done():
+			diags.Append(listDiags...)
+			diags.AddError(
+				"Resources were not deleted",
+				fmt.Sprintf("The following resources were still present after %s:\n%s", timeout, formatBlockingResources(remaining)),
+			)
+			return
+		case <-ticker.C:
+		}
+	}
+}
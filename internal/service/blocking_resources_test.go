@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestEnvironmentResourcesFromBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []blockingResource
+	}{
+		{
+			"resources of all kinds",
+			`{
+				"name": "production",
+				"applications": [{"uuid": "a1", "name": "web"}],
+				"services": [{"uuid": "s1", "name": "plausible"}],
+				"postgresqls": [{"uuid": "p1", "name": "db"}, {"uuid": "p2", "name": "db-replica"}],
+				"redis": [{"uuid": "r1", "name": "cache"}]
+			}`,
+			[]blockingResource{
+				{Uuid: "a1", Name: "web", Type: "application"},
+				{Uuid: "s1", Name: "plausible", Type: "service"},
+				{Uuid: "p1", Name: "db", Type: "standalone-postgresql"},
+				{Uuid: "p2", Name: "db-replica", Type: "standalone-postgresql"},
+				{Uuid: "r1", Name: "cache", Type: "standalone-redis"},
+			},
+		},
+		{"empty environment", `{"name": "production", "applications": [], "services": []}`, nil},
+		{"unexpected shapes", `{"applications": {"uuid": "a1"}, "services": "none"}`, nil},
+		{"invalid body", `not json`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, environmentResourcesFromBody([]byte(tt.body)))
+		})
+	}
+}
+
+func TestFormatBlockingResources(t *testing.T) {
+	resources := []blockingResource{
+		{Uuid: "a1", Name: "web", Type: "application"},
+		{Uuid: "p1", Name: "db", Type: "standalone-postgresql"},
+	}
+
+	expected := "  - web (application, uuid=a1)\n  - db (standalone-postgresql, uuid=p1)"
+	assert.Equal(t, expected, formatBlockingResources(resources))
+}
+
+func TestDeleteBlockingResources(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		deleted = append(deleted, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"message": "Deletion request queued."}`)
+	}))
+	defer server.Close()
+
+	client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+	require.NoError(t, err)
+
+	t.Run("known types", func(t *testing.T) {
+		deleted = nil
+		var diags diag.Diagnostics
+		deleteBlockingResources(context.Background(), client, &diags, []blockingResource{
+			{Uuid: "a1", Name: "web", Type: "application"},
+			{Uuid: "s1", Name: "plausible", Type: "service"},
+			{Uuid: "p1", Name: "db", Type: "standalone-postgresql"},
+		})
+
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, []string{"DELETE /applications/a1", "DELETE /services/s1", "DELETE /databases/p1"}, deleted)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		deleted = nil
+		var diags diag.Diagnostics
+		deleteBlockingResources(context.Background(), client, &diags, []blockingResource{
+			{Uuid: "x1", Name: "unknown", Type: "standalone-newdb"},
+		})
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Unsupported resource type", diags.Errors()[0].Summary())
+		assert.Empty(t, deleted, "nothing is deleted on a guess")
+	})
+}
+
+func TestBlockingResourcesUndecodableBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxy answering with a page instead of JSON
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html>OK</html>`)
+	}))
+	defer server.Close()
+
+	client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+	require.NoError(t, err)
+
+	var serverDiags diag.Diagnostics
+	assert.Nil(t, serverBlockingResources(context.Background(), client, &serverDiags, "s1"))
+	require.True(t, serverDiags.HasError())
+	assert.Contains(t, serverDiags.Errors()[0].Detail(), "Unable to decode")
+
+	var projectDiags diag.Diagnostics
+	assert.Nil(t, projectBlockingResources(context.Background(), client, &projectDiags, "p1"))
+	require.True(t, projectDiags.HasError())
+	assert.Contains(t, projectDiags.Errors()[0].Detail(), "Unable to decode")
+}
+
+func TestDeleteAlreadyDeleted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "nothing is deleted")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "Not found."}`)
+	}))
+	defer server.Close()
+	client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		resource resource.ResourceWithConfigure
+	}{
+		{"server", &serverResource{client: client}},
+		{"project", &projectResource{client: client}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			schemaResp := &resource.SchemaResponse{}
+			tt.resource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			// Only the UUID is set
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["uuid"] = tftypes.NewValue(tftypes.String, "u1")
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+			resp := &resource.DeleteResponse{State: state}
+			tt.resource.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+)
+
+// exitCodeMarker prefixes the line that reports the exit code of a command.
+// Coolify only returns the output of a command, so the exit code is echoed after it.
+const exitCodeMarker = "__TF_COOLIFY_EXIT_CODE__="
+
+// withExitCode appends the report of the exit code to a command.
+// The command runs in a subshell, so the exit code is reported even when it calls `exit`.
+func withExitCode(command string) string {
+	return "(\n" + command + "\n)\necho \"" + exitCodeMarker + "$?\""
+}
+
+// splitExitCode separates the exit code reported by withExitCode from the output of a command.
+// ok is false when the report is missing or invalid, in which case the exit code is unknown and the output is returned as is.
+func splitExitCode(output string) (string, int64, bool) {
+	trimmed := strings.TrimRight(output, "\n")
+	index := strings.LastIndex(trimmed, exitCodeMarker)
+	if index == -1 {
+		return output, 0, false
+	}
+
+	exitCode, err := strconv.ParseInt(strings.TrimSpace(trimmed[index+len(exitCodeMarker):]), 10, 64)
+	if err != nil {
+		return output, 0, false
+	}
+
+	return strings.TrimRight(trimmed[:index], "\n"), exitCode, true
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitExitCode(t *testing.T) {
+	tests := []struct {
+		name             string
+		output           string
+		expectedOutput   string
+		expectedExitCode int64
+		expectedOk       bool
+	}{
+		{"success", "migrated\n" + exitCodeMarker + "0\n", "migrated", 0, true},
+		{"failure", "error: table exists\n" + exitCodeMarker + "1", "error: table exists", 1, true},
+		{"no output", exitCodeMarker + "0", "", 0, true},
+		{"no report", "migrated\n", "migrated\n", 0, false},
+		{"invalid report", "migrated\n" + exitCodeMarker + "x", "migrated\n" + exitCodeMarker + "x", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, exitCode, ok := splitExitCode(tt.output)
+			assert.Equal(t, tt.expectedOutput, output)
+			assert.Equal(t, tt.expectedExitCode, exitCode)
+			assert.Equal(t, tt.expectedOk, ok)
+		})
+	}
+}
+
+func TestWithExitCode(t *testing.T) {
+	assert.Equal(t, "(\nphp artisan migrate\n)\necho \""+exitCodeMarker+"$?\"", withExitCode("php artisan migrate"))
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+// createReconcilePollInterval is the time between lookups of a resource after an ambiguous create failure.
+var createReconcilePollInterval = 5 * time.Second
+
+// createReconcileAttempts is the number of lookups, as Coolify may still be processing the request that timed out.
+const createReconcileAttempts = 3
+
+// createCall sends a create request and returns the UUID of the created resource, or an empty UUID when it failed.
+type createCall func() (uuid string, httpResp *http.Response, body []byte, err error)
+
+// createTarget is a resource about to be created in an environment, ie a database.
+// Coolify does not support idempotency keys, so the only way to tell whether an ambiguous create request
+// created a resource is to look for a new resource with the same name in the same environment.
+type createTarget struct {
+	ProjectUuid     string
+	EnvironmentName string
+	Type            string
+	Name            string
+
+	// Description is used in diagnostics, ie `postgresql database`.
+	Description string
+}
+
+// key identifies the resources that a create request for the target could be confused with.
+func (t createTarget) key() string {
+	return t.ProjectUuid + "/" + t.EnvironmentName + "/" + t.Type + "/" + t.Name
+}
+
+// inFlightCreates tracks the create requests in progress for each target key.
+// A new resource can only be adopted when no other create for the same key ran at the same time,
+// as it may have been created by that request instead.
+var inFlightCreates = struct {
+	sync.Mutex
+	creates map[string][]*inFlightCreate
+}{creates: map[string][]*inFlightCreate{}}
+
+type inFlightCreate struct {
+	// overlapped is set when another create for the same key was in progress at the same time, guarded by inFlightCreates.
+	overlapped bool
+}
+
+// startCreate registers a create request for key, and returns a function to call once it is done.
+func startCreate(key string) (*inFlightCreate, func()) {
+	create := &inFlightCreate{}
+
+	inFlightCreates.Lock()
+	defer inFlightCreates.Unlock()
+	others := inFlightCreates.creates[key]
+	for _, other := range others {
+		other.overlapped = true
+		create.overlapped = true
+	}
+	inFlightCreates.creates[key] = append(others, create)
+
+	return create, func() {
+		inFlightCreates.Lock()
+		defer inFlightCreates.Unlock()
+		inFlightCreates.creates[key] = slices.DeleteFunc(inFlightCreates.creates[key], func(other *inFlightCreate) bool { return other == create })
+		if len(inFlightCreates.creates[key]) == 0 {
+			delete(inFlightCreates.creates, key)
+		}
+	}
+}
+
+// hasOverlapped reports whether another create for the same key ran at the same time.
+func (c *inFlightCreate) hasOverlapped() bool {
+	inFlightCreates.Lock()
+	defer inFlightCreates.Unlock()
+	return c.overlapped
+}
+
+func databaseCreateTarget(databaseType, description string, plan commonDatabaseModel) createTarget {
+	return createTarget{
+		ProjectUuid:     plan.ProjectUuid.ValueString(),
+		EnvironmentName: plan.EnvironmentName.ValueString(),
+		Type:            databaseType,
+		Name:            plan.Name.ValueString(),
+		Description:     description,
+	}
+}
+
+// createReconciled creates a resource and returns its UUID, or an empty string when it failed.
+// When the create request fails after Coolify may have received it, ie on a timeout or a server error,
+// a resource that did not exist before the request is adopted instead of failing and creating a second one on the next apply.
+// Nothing is adopted when another create for a resource with the same name ran at the same time.
+func createReconciled(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	target createTarget,
+	model any,
+	create createCall,
+) string {
+	operation := "creating " + target.Description
+
+	inFlight, done := startCreate(target.key())
+	defer done()
+
+	existing, ok := target.lookup(ctx, client)
+	if !ok {
+		// Without knowing what existed before, a resource can not be adopted safely
+		tflog.Warn(ctx, "Unable to list existing resources before create, ambiguous failures will not be reconciled", map[string]interface{}{
+			"project_uuid":     target.ProjectUuid,
+			"environment_name": target.EnvironmentName,
+		})
+	}
+
+	uuid, httpResp, body, err := create()
+	if err == nil && uuid != "" {
+		return uuid
+	}
+
+	// A successful response without a UUID means the resource was created, but its UUID is unknown
+	undecodable := err == nil && httpResp != nil && httpResp.StatusCode >= 200 && httpResp.StatusCode < 300
+
+	if ok && (undecodable || isAmbiguousFailure(httpResp, err)) {
+		if inFlight.hasOverlapped() {
+			tflog.Warn(ctx, "Another create for a resource with the same name ran at the same time, the ambiguous failure will not be reconciled", map[string]interface{}{
+				"name": target.Name,
+			})
+		} else if adopted := target.adopt(ctx, client, existing); adopted != "" {
+			diags.AddWarning(
+				fmt.Sprintf("Adopted %s after an ambiguous failure", target.Description),
+				fmt.Sprintf("The request failed after Coolify may have received it, and a new %s named %q was found in environment %q: uuid=%s.",
+					target.Description, target.Name, target.EnvironmentName, adopted),
+			)
+			return adopted
+		}
+	}
+
+	switch {
+	case err != nil:
+		diags.AddError("Error "+operation, err.Error())
+	case undecodable:
+		diags.AddError(
+			"Error "+operation,
+			fmt.Sprintf("Unable to decode the %s returned by Coolify. The %s may have been created, check Coolify before applying again. Response: %s",
+				target.Description, target.Description, body),
+		)
+	default:
+		util.AddAPIError(diags, operation, httpResp, body, model)
+	}
+	return ""
+}
+
+// isAmbiguousFailure reports whether a failed request may still have been processed by Coolify.
+func isAmbiguousFailure(httpResp *http.Response, err error) bool {
+	if err != nil {
+		return !api.IsConnectionError(err)
+	}
+	return httpResp != nil && httpResp.StatusCode >= http.StatusInternalServerError
+}
+
+// adopt looks for a resource of the target that is not one of the existing resources.
+// Nothing is adopted when there is more than one, as it is unknown which one was created by the request.
+func (t createTarget) adopt(ctx context.Context, client *api.ClientWithResponses, existing []string) string {
+	for attempt := 1; attempt <= createReconcileAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return ""
+			case <-time.After(createReconcilePollInterval):
+			}
+		}
+
+		current, ok := t.lookup(ctx, client)
+		if !ok {
+			continue
+		}
+
+		var created []string
+		for _, uuid := range current {
+			if !slices.Contains(existing, uuid) {
+				created = append(created, uuid)
+			}
+		}
+		if len(created) == 1 {
+			return created[0]
+		}
+		if len(created) > 1 {
+			tflog.Warn(ctx, "Found more than one new resource after an ambiguous create failure", map[string]interface{}{
+				"uuids": created,
+			})
+			return ""
+		}
+	}
+	return ""
+}
+
+// lookup returns the UUIDs of the resources of the target type and name in the environment.
+func (t createTarget) lookup(ctx context.Context, client *api.ClientWithResponses) ([]string, bool) {
+	envResp, err := client.GetEnvironmentByNameOrUuidWithResponse(ctx, t.ProjectUuid, t.EnvironmentName)
+	if err != nil || envResp.StatusCode() != http.StatusOK {
+		return nil, false
+	}
+
+	var uuids []string
+	for _, res := range environmentResourcesFromBody(envResp.Body) {
+		if res.Type == t.Type && res.Name == t.Name {
+			uuids = append(uuids, res.Uuid)
+		}
+	}
+	return uuids, true
+}
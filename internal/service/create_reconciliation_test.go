@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestCreateReconciled(t *testing.T) {
+	setCreateReconcilePollInterval(t, 0)
+
+	target := createTarget{
+		ProjectUuid:     "project",
+		EnvironmentName: "production",
+		Type:            "standalone-postgresql",
+		Name:            "db",
+		Description:     "postgresql database",
+	}
+	timeout := &net.OpError{Op: "read", Err: errors.New("i/o timeout")}
+	refused := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+
+	tests := []struct {
+		name        string
+		created     bool
+		status      int
+		uuid        string
+		err         error
+		overlapped  bool
+		expected    string
+		expectError bool
+	}{
+		{"created", false, http.StatusCreated, "new", nil, false, "new", false},
+		{"timeout after the database was created", true, 0, "", timeout, false, "new", false},
+		{"server error after the database was created", true, http.StatusGatewayTimeout, "", nil, false, "new", false},
+		{"undecodable response after the database was created", true, http.StatusCreated, "", nil, false, "new", false},
+		{"undecodable response", false, http.StatusCreated, "", nil, false, "", true},
+		{"timeout before the database was created", false, 0, "", timeout, false, "", true},
+		{"timeout while another create ran", true, 0, "", timeout, true, "", true},
+		{"connection refused", true, 0, "", refused, false, "", true},
+		{"validation error", true, http.StatusUnprocessableEntity, "", nil, false, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lookups atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/projects/project/production", r.URL.Path)
+				databases := []string{`{"uuid": "old", "name": "db"}`, `{"uuid": "other", "name": "cache"}`}
+				if lookups.Add(1) > 1 && tt.created {
+					databases = append(databases, `{"uuid": "new", "name": "db"}`)
+				}
+				fmt.Fprintf(w, `{"name": "production", "postgresqls": [%s]}`, strings.Join(databases, ","))
+			}))
+			defer server.Close()
+
+			client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+			require.NoError(t, err)
+
+			var diags diag.Diagnostics
+			uuid := createReconciled(context.Background(), client, &diags, target, nil, func() (string, *http.Response, []byte, error) {
+				if tt.overlapped {
+					// Another create for the same name starts while this one is in progress
+					_, done := startCreate(target.key())
+					defer done()
+				}
+				if tt.err != nil {
+					return "", nil, nil, tt.err
+				}
+				if tt.status == http.StatusCreated {
+					return tt.uuid, &http.Response{StatusCode: tt.status}, []byte(`<html>`), nil
+				}
+				return "", &http.Response{StatusCode: tt.status}, []byte(`{"message": "failed"}`), nil
+			})
+
+			assert.Equal(t, tt.expected, uuid)
+			assert.Equal(t, tt.expectError, diags.HasError(), diags)
+			if tt.expected != "" && tt.uuid == "" {
+				assert.Equal(t, 1, diags.WarningsCount())
+			}
+			if tt.status == http.StatusCreated && tt.expectError {
+				assert.Contains(t, diags.Errors()[0].Detail(), "Unable to decode the postgresql database returned by Coolify.")
+			}
+		})
+	}
+}
+
+func TestCreateReconciledAmbiguousMatches(t *testing.T) {
+	setCreateReconcilePollInterval(t, 0)
+
+	var lookups atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if lookups.Add(1) == 1 {
+			fmt.Fprint(w, `{"postgresqls": []}`)
+			return
+		}
+		fmt.Fprint(w, `{"postgresqls": [{"uuid": "a", "name": "db"}, {"uuid": "b", "name": "db"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+	require.NoError(t, err)
+
+	target := createTarget{ProjectUuid: "project", EnvironmentName: "production", Type: "standalone-postgresql", Name: "db", Description: "postgresql database"}
+	var diags diag.Diagnostics
+	uuid := createReconciled(context.Background(), client, &diags, target, nil, func() (string, *http.Response, []byte, error) {
+		return "", nil, nil, context.DeadlineExceeded
+	})
+
+	assert.Empty(t, uuid)
+	assert.True(t, diags.HasError())
+}
+
+func TestStartCreate(t *testing.T) {
+	first, doneFirst := startCreate("project/production/standalone-postgresql/db")
+	other, doneOther := startCreate("project/production/standalone-postgresql/cache")
+	defer doneOther()
+	assert.False(t, first.hasOverlapped())
+
+	second, doneSecond := startCreate("project/production/standalone-postgresql/db")
+	assert.True(t, first.hasOverlapped())
+	assert.True(t, second.hasOverlapped())
+	assert.False(t, other.hasOverlapped())
+
+	doneFirst()
+	doneSecond()
+	third, doneThird := startCreate("project/production/standalone-postgresql/db")
+	defer doneThird()
+	assert.False(t, third.hasOverlapped())
+}
+
+func setCreateReconcilePollInterval(t *testing.T, interval time.Duration) {
+	previous := createReconcilePollInterval
+	createReconcilePollInterval = interval
+	t.Cleanup(func() { createReconcilePollInterval = previous })
+}
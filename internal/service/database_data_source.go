@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var _ datasource.DataSource = &databaseDataSource{}
+var _ datasource.DataSourceWithConfigure = &databaseDataSource{}
+
+func NewDatabaseDataSource() datasource.DataSource {
+	return &databaseDataSource{}
+}
+
+type databaseDataSource struct {
+	client *api.ClientWithResponses
+}
+
+func (d *databaseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (d *databaseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := databaseDataSourceAttributes()
+	attributes["uuid"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The UUID of the database.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Get a Coolify database by `uuid`. Settings specific to the database type are exposed in a nested attribute named after the engine, ie `postgresql`.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *databaseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	util.ProviderDataFromDataSourceConfigureRequest(req, &d.client, resp)
+}
+
+func (d *databaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan databaseDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseResp, err := d.client.GetDatabaseByUuidWithResponse(ctx, plan.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading database", err.Error(),
+		)
+		return
+	}
+
+	if databaseResp.StatusCode() != http.StatusOK || databaseResp.JSON200 == nil {
+		util.AddAPIError(&resp.Diagnostics, "reading database", databaseResp.HTTPResponse, databaseResp.Body, nil)
+		return
+	}
+
+	state, diag := databaseDataSourceModel{}.FromAPI(databaseResp.JSON200)
+	resp.Diagnostics.Append(diag...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,396 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/flatten"
+)
+
+type databaseDataSourceModel struct {
+	CreatedAt               types.String `tfsdk:"created_at"`
+	DatabaseType            types.String `tfsdk:"database_type"`
+	Description             types.String `tfsdk:"description"`
+	DestinationId           types.Int64  `tfsdk:"destination_id"`
+	EnvironmentId           types.Int64  `tfsdk:"environment_id"`
+	ExternalDbUrl           types.String `tfsdk:"external_db_url"`
+	Image                   types.String `tfsdk:"image"`
+	InternalDbUrl           types.String `tfsdk:"internal_db_url"`
+	IsPublic                types.Bool   `tfsdk:"is_public"`
+	LimitsCpuShares         types.Int64  `tfsdk:"limits_cpu_shares"`
+	LimitsCpus              types.String `tfsdk:"limits_cpus"`
+	LimitsCpuset            types.String `tfsdk:"limits_cpuset"`
+	LimitsMemory            types.String `tfsdk:"limits_memory"`
+	LimitsMemoryReservation types.String `tfsdk:"limits_memory_reservation"`
+	LimitsMemorySwap        types.String `tfsdk:"limits_memory_swap"`
+	LimitsMemorySwappiness  types.Int64  `tfsdk:"limits_memory_swappiness"`
+	Name                    types.String `tfsdk:"name"`
+	PublicPort              types.Int64  `tfsdk:"public_port"`
+	Status                  types.String `tfsdk:"status"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+	Uuid                    types.String `tfsdk:"uuid"`
+
+	// Engine specific settings, only the one matching `database_type` is set
+	Clickhouse types.Object `tfsdk:"clickhouse"`
+	Dragonfly  types.Object `tfsdk:"dragonfly"`
+	Keydb      types.Object `tfsdk:"keydb"`
+	Mariadb    types.Object `tfsdk:"mariadb"`
+	Mongodb    types.Object `tfsdk:"mongodb"`
+	Mysql      types.Object `tfsdk:"mysql"`
+	Postgresql types.Object `tfsdk:"postgresql"`
+	Redis      types.Object `tfsdk:"redis"`
+}
+
+type databaseEngineField struct {
+	Name        string
+	Description string
+	Sensitive   bool
+}
+
+type databaseEngine struct {
+	Attribute    string
+	DatabaseType string
+	Fields       []databaseEngineField
+}
+
+// databaseEngines describes the settings exposed for each database type.
+// Only PostgreSQL and MySQL are part of the documented API model, other engines are read from the raw response.
+var databaseEngines = []databaseEngine{
+	{
+		Attribute:    "clickhouse",
+		DatabaseType: "standalone-clickhouse",
+		Fields: []databaseEngineField{
+			{Name: "clickhouse_admin_user", Description: "ClickHouse admin user"},
+			{Name: "clickhouse_admin_password", Description: "ClickHouse admin password", Sensitive: true},
+		},
+	},
+	{
+		Attribute:    "dragonfly",
+		DatabaseType: "standalone-dragonfly",
+		Fields: []databaseEngineField{
+			{Name: "dragonfly_password", Description: "DragonFly password", Sensitive: true},
+		},
+	},
+	{
+		Attribute:    "keydb",
+		DatabaseType: "standalone-keydb",
+		Fields: []databaseEngineField{
+			{Name: "keydb_conf", Description: "KeyDB configuration"},
+			{Name: "keydb_password", Description: "KeyDB password", Sensitive: true},
+		},
+	},
+	{
+		Attribute:    "mariadb",
+		DatabaseType: "standalone-mariadb",
+		Fields: []databaseEngineField{
+			{Name: "mariadb_conf", Description: "MariaDB configuration"},
+			{Name: "mariadb_database", Description: "MariaDB database"},
+			{Name: "mariadb_password", Description: "MariaDB password", Sensitive: true},
+			{Name: "mariadb_root_password", Description: "MariaDB root password", Sensitive: true},
+			{Name: "mariadb_user", Description: "MariaDB user"},
+		},
+	},
+	{
+		Attribute:    "mongodb",
+		DatabaseType: "standalone-mongodb",
+		Fields: []databaseEngineField{
+			{Name: "mongo_conf", Description: "MongoDB configuration"},
+			{Name: "mongo_initdb_database", Description: "MongoDB initial database"},
+			{Name: "mongo_initdb_root_password", Description: "MongoDB root password", Sensitive: true},
+			{Name: "mongo_initdb_root_username", Description: "MongoDB root username"},
+		},
+	},
+	{
+		Attribute:    "mysql",
+		DatabaseType: "standalone-mysql",
+		Fields: []databaseEngineField{
+			{Name: "mysql_conf", Description: "MySQL configuration"},
+			{Name: "mysql_database", Description: "MySQL database"},
+			{Name: "mysql_password", Description: "MySQL password", Sensitive: true},
+			{Name: "mysql_root_password", Description: "MySQL root password", Sensitive: true},
+			{Name: "mysql_user", Description: "MySQL user"},
+		},
+	},
+	{
+		Attribute:    "postgresql",
+		DatabaseType: "standalone-postgresql",
+		Fields: []databaseEngineField{
+			{Name: "postgres_conf", Description: "PostgreSQL configuration"},
+			{Name: "postgres_db", Description: "PostgreSQL database"},
+			{Name: "postgres_host_auth_method", Description: "PostgreSQL host auth method"},
+			{Name: "postgres_initdb_args", Description: "PostgreSQL initdb args"},
+			{Name: "postgres_password", Description: "PostgreSQL password", Sensitive: true},
+			{Name: "postgres_user", Description: "PostgreSQL user"},
+		},
+	},
+	{
+		Attribute:    "redis",
+		DatabaseType: "standalone-redis",
+		Fields: []databaseEngineField{
+			{Name: "redis_conf", Description: "Redis configuration"},
+			{Name: "redis_password", Description: "Redis password", Sensitive: true},
+			{Name: "redis_username", Description: "Redis username"},
+		},
+	},
+}
+
+var _ filter.FilterableStructModel = databaseDataSourceModel{}
+
+var databasesMostRecentField = "created_at"
+
+func (m databaseDataSourceModel) FilterAttributes() map[string]attr.Value {
+	return filter.StructAttributes(m)
+}
+
+func (e databaseEngine) attributeTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(e.Fields))
+	for _, field := range e.Fields {
+		attrTypes[field.Name] = types.StringType
+	}
+	return attrTypes
+}
+
+func (e databaseEngine) schema() schema.SingleNestedAttribute {
+	attributes := make(map[string]schema.Attribute, len(e.Fields))
+	for _, field := range e.Fields {
+		attributes[field.Name] = schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   field.Sensitive,
+			Description: field.Description,
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: fmt.Sprintf("Settings specific to `%s` databases. Null for other database types.", e.DatabaseType),
+		Attributes:  attributes,
+	}
+}
+
+// databaseDataSourceAttributes returns the attributes of a database, shared by the singular and list data sources.
+func databaseDataSourceAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date and time the database was created.",
+		},
+		"database_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the database, ie `standalone-postgresql`.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "The description of the database.",
+		},
+		"destination_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The identifier of the destination the database is deployed to.",
+		},
+		"environment_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The identifier of the environment the database belongs to.",
+		},
+		"external_db_url": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The public connection URL of the database, if it is public.",
+		},
+		"image": schema.StringAttribute{
+			Computed:    true,
+			Description: "The Docker image of the database.",
+		},
+		"internal_db_url": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The internal connection URL of the database.",
+		},
+		"is_public": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the database is publicly accessible.",
+		},
+		"limits_cpu_shares": schema.Int64Attribute{
+			Computed:    true,
+			Description: "CPU shares of the database.",
+		},
+		"limits_cpus": schema.StringAttribute{
+			Computed:    true,
+			Description: "CPU limit of the database.",
+		},
+		"limits_cpuset": schema.StringAttribute{
+			Computed:    true,
+			Description: "CPU set of the database.",
+		},
+		"limits_memory": schema.StringAttribute{
+			Computed:    true,
+			Description: "Memory limit of the database.",
+		},
+		"limits_memory_reservation": schema.StringAttribute{
+			Computed:    true,
+			Description: "Memory reservation of the database.",
+		},
+		"limits_memory_swap": schema.StringAttribute{
+			Computed:    true,
+			Description: "Memory swap limit of the database.",
+		},
+		"limits_memory_swappiness": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Memory swappiness of the database.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the database.",
+		},
+		"public_port": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The public port of the database.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the database, ie `running:healthy`.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date and time the database was last updated.",
+		},
+		"uuid": schema.StringAttribute{
+			Computed:    true,
+			Description: "The UUID of the database.",
+		},
+	}
+
+	for _, engine := range databaseEngines {
+		attributes[engine.Attribute] = engine.schema()
+	}
+
+	return attributes
+}
+
+func (m databaseDataSourceModel) FromAPI(apiModel *api.Database) (databaseDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	db, err := apiModel.AsDatabaseCommon()
+	if err != nil {
+		diags.AddError("Error decoding database", err.Error())
+		return databaseDataSourceModel{}, diags
+	}
+
+	raw, err := apiModel.MarshalJSON()
+	if err != nil {
+		diags.AddError("Error decoding database", err.Error())
+		return databaseDataSourceModel{}, diags
+	}
+
+	// These fields are returned by Coolify, but not part of the documented API model
+	var extra struct {
+		DestinationId *int    `json:"destination_id"`
+		EnvironmentId *int    `json:"environment_id"`
+		ExternalDbUrl *string `json:"external_db_url"`
+		Status        *string `json:"status"`
+	}
+	_ = json.Unmarshal(raw, &extra)
+
+	model := databaseDataSourceModel{
+		CreatedAt:               flatten.Time(db.CreatedAt),
+		DatabaseType:            flatten.RequiredString(db.DatabaseType),
+		Description:             flatten.String(db.Description),
+		DestinationId:           flatten.Int64(extra.DestinationId),
+		EnvironmentId:           flatten.Int64(extra.EnvironmentId),
+		ExternalDbUrl:           flatten.String(extra.ExternalDbUrl),
+		Image:                   flatten.String(db.Image),
+		InternalDbUrl:           flatten.String(db.InternalDbUrl),
+		IsPublic:                flatten.Bool(db.IsPublic),
+		LimitsCpuShares:         flatten.Int64(db.LimitsCpuShares),
+		LimitsCpus:              flatten.String(db.LimitsCpus),
+		LimitsCpuset:            flatten.String(db.LimitsCpuset),
+		LimitsMemory:            flatten.String(db.LimitsMemory),
+		LimitsMemoryReservation: flatten.String(db.LimitsMemoryReservation),
+		LimitsMemorySwap:        flatten.String(db.LimitsMemorySwap),
+		LimitsMemorySwappiness:  flatten.Int64(db.LimitsMemorySwappiness),
+		Name:                    flatten.String(db.Name),
+		PublicPort:              flatten.Int64(db.PublicPort),
+		Status:                  flatten.String(extra.Status),
+		UpdatedAt:               flatten.Time(db.UpdatedAt),
+		Uuid:                    flatten.RequiredString(db.Uuid),
+	}
+
+	engines := make(map[string]types.Object, len(databaseEngines))
+	for _, engine := range databaseEngines {
+		if engine.DatabaseType != db.DatabaseType {
+			engines[engine.Attribute] = types.ObjectNull(engine.attributeTypes())
+			continue
+		}
+
+		values, err := databaseEngineValues(apiModel, engine, raw)
+		if err != nil {
+			diags.AddError("Error decoding database", fmt.Sprintf("Could not decode %s database: %s", engine.DatabaseType, err))
+			engines[engine.Attribute] = types.ObjectNull(engine.attributeTypes())
+			continue
+		}
+
+		obj, diag := types.ObjectValue(engine.attributeTypes(), values)
+		diags.Append(diag...)
+		engines[engine.Attribute] = obj
+	}
+
+	model.Clickhouse = engines["clickhouse"]
+	model.Dragonfly = engines["dragonfly"]
+	model.Keydb = engines["keydb"]
+	model.Mariadb = engines["mariadb"]
+	model.Mongodb = engines["mongodb"]
+	model.Mysql = engines["mysql"]
+	model.Postgresql = engines["postgresql"]
+	model.Redis = engines["redis"]
+
+	return model, diags
+}
+
+// databaseEngineValues decodes the engine specific settings of a database.
+func databaseEngineValues(apiModel *api.Database, engine databaseEngine, raw []byte) (map[string]attr.Value, error) {
+	values := make(map[string]attr.Value, len(engine.Fields))
+
+	switch engine.DatabaseType {
+	case "standalone-postgresql":
+		db, err := apiModel.AsPostgresqlDatabase()
+		if err != nil {
+			return nil, err
+		}
+		values["postgres_conf"] = flatten.String(db.PostgresConf)
+		values["postgres_db"] = flatten.String(db.PostgresDb)
+		values["postgres_host_auth_method"] = flatten.String(db.PostgresHostAuthMethod)
+		values["postgres_initdb_args"] = flatten.String(db.PostgresInitdbArgs)
+		values["postgres_password"] = flatten.String(db.PostgresPassword)
+		values["postgres_user"] = flatten.String(db.PostgresUser)
+	case "standalone-mysql":
+		db, err := apiModel.AsMysqlDatabase()
+		if err != nil {
+			return nil, err
+		}
+		values["mysql_conf"] = flatten.String(db.MysqlConf)
+		values["mysql_database"] = flatten.String(db.MysqlDatabase)
+		values["mysql_password"] = flatten.String(db.MysqlPassword)
+		values["mysql_root_password"] = flatten.String(db.MysqlRootPassword)
+		values["mysql_user"] = flatten.String(db.MysqlUser)
+	default:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+		for _, field := range engine.Fields {
+			// Missing or non-string values are left null
+			var value *string
+			if err := json.Unmarshal(fields[field.Name], &value); err != nil {
+				value = nil
+			}
+			values[field.Name] = flatten.String(value)
+		}
+	}
+
+	return values, nil
+}
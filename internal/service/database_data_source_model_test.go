@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+)
+
+func TestDatabaseDataSourceModel_FromAPI(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		engine   string
+		expected map[string]types.String
+	}{
+		{
+			name:   "postgresql",
+			body:   `{"uuid":"pg1","name":"pg","database_type":"standalone-postgresql","status":"running:healthy","is_public":false,"postgres_user":"postgres","postgres_password":"secret","postgres_db":"app"}`,
+			engine: "postgresql",
+			expected: map[string]types.String{
+				"postgres_user":     types.StringValue("postgres"),
+				"postgres_password": types.StringValue("secret"),
+				"postgres_db":       types.StringValue("app"),
+				"postgres_conf":     types.StringNull(),
+			},
+		},
+		{
+			name:   "redis is read from the raw response",
+			body:   `{"uuid":"rd1","name":"redis","database_type":"standalone-redis","status":"exited","redis_password":"secret","redis_conf":null,"redis_username":42}`,
+			engine: "redis",
+			expected: map[string]types.String{
+				"redis_password": types.StringValue("secret"),
+				"redis_conf":     types.StringNull(),
+				"redis_username": types.StringNull(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var db api.Database
+			require.NoError(t, db.UnmarshalJSON([]byte(tt.body)))
+
+			model, diags := databaseDataSourceModel{}.FromAPI(&db)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			attributes := model.FilterAttributes()
+			assert.NotEmpty(t, model.Status.ValueString())
+
+			for _, engine := range databaseEngines {
+				value, ok := attributes[engine.Attribute].(types.Object)
+				require.True(t, ok, "engine %s should be an object", engine.Attribute)
+				assert.Equal(t, engine.Attribute != tt.engine, value.IsNull(), "engine %s", engine.Attribute)
+			}
+
+			engineAttributes := attributes[tt.engine].(types.Object).Attributes()
+			for name, expected := range tt.expected {
+				assert.Equal(t, expected, engineAttributes[name], "field %s", name)
+			}
+		})
+	}
+}
+
+func TestDatabaseDataSourceModel_Attributes(t *testing.T) {
+	resp := &datasource.SchemaResponse{}
+	NewDatabasesDataSource().Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	nested := resp.Schema.Attributes["databases"].(schema.ListNestedAttribute).NestedObject.Attributes
+	filterNames := filter.NamesFromSchema(nested)
+
+	for _, name := range []string{"database_type", "name", "status", "is_public", "postgresql.postgres_user"} {
+		assert.Contains(t, filterNames, name)
+	}
+	for _, name := range []string{"internal_db_url", "external_db_url", "postgresql.postgres_password"} {
+		assert.NotContains(t, filterNames, name)
+	}
+
+	// Every filter name derived from the list schema must be filterable
+	attributes := databaseDataSourceModel{}.FilterAttributes()
+	for _, name := range filterNames {
+		parent, child, nested := strings.Cut(name, ".")
+		assert.Contains(t, attributes, parent)
+		if nested {
+			assert.Contains(t, databaseEngineByAttribute(t, parent).attributeTypes(), child)
+		}
+	}
+}
+
+func databaseEngineByAttribute(t *testing.T, attribute string) databaseEngine {
+	for _, engine := range databaseEngines {
+		if engine.Attribute == attribute {
+			return engine
+		}
+	}
+	t.Fatalf("unknown database engine %s", attribute)
+	return databaseEngine{}
+}
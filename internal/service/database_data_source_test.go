@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func testAccDatabaseDataSourcePostgresqlConfig(name string) string {
+	return `
+	resource "coolify_postgresql_database" "test" {
+		name = "` + name + `"
+
+		server_uuid      = "` + acctest.ServerUUID + `"
+		project_uuid     = "` + acctest.ProjectUUID + `"
+		environment_name = "` + acctest.EnvironmentName + `"
+
+		image             = "postgres:16-alpine"
+		postgres_db       = "postgres"
+		postgres_user     = "postgres"
+		postgres_password = "password"
+	}
+	`
+}
+
+func TestAccDatabaseDataSource(t *testing.T) {
+	name := acctest.GetRandomResourceName("db")
+	resName := "data.coolify_database.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseDataSourcePostgresqlConfig(name) + `
+				data "coolify_database" "test" {
+					uuid = coolify_postgresql_database.test.uuid
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "uuid", "coolify_postgresql_database.test", "uuid"),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "database_type", "standalone-postgresql"),
+					resource.TestCheckResourceAttr(resName, "postgresql.postgres_user", "postgres"),
+					resource.TestCheckResourceAttr(resName, "postgresql.postgres_password", "password"),
+					resource.TestCheckResourceAttrSet(resName, "internal_db_url"),
+					resource.TestCheckNoResourceAttr(resName, "mysql.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := service.NewDatabaseDataSource()
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.True(t, resp.Schema.Attributes["uuid"].IsRequired())
+	assert.True(t, resp.Schema.Attributes["internal_db_url"].IsSensitive())
+	assert.True(t, resp.Schema.Attributes["external_db_url"].IsSensitive())
+	for _, engine := range []string{"postgresql", "mysql", "mariadb", "mongodb", "redis", "keydb", "dragonfly", "clickhouse"} {
+		assert.Contains(t, resp.Schema.Attributes, engine)
+	}
+}
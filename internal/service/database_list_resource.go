@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ list.ListResource              = &postgresqlDatabaseResource{}
+	_ list.ListResourceWithConfigure = &postgresqlDatabaseResource{}
+	_ list.ListResource              = &mysqlDatabaseResource{}
+	_ list.ListResourceWithConfigure = &mysqlDatabaseResource{}
+)
+
+func NewPostgresqlDatabaseListResource() list.ListResource {
+	return &postgresqlDatabaseResource{}
+}
+
+func NewMySQLDatabaseListResource() list.ListResource {
+	return &mysqlDatabaseResource{}
+}
+
+func (r *postgresqlDatabaseResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceSchema(ctx, &resp.Diagnostics,
+		"List Coolify PostgreSQL databases to import, with the same filters as the `coolify_databases` data source.",
+		NewDatabasesDataSource(), "databases", databasesMostRecentField)
+}
+
+func (r *postgresqlDatabaseResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = listDatabases(ctx, r.client, req, "standalone-postgresql",
+		func(result *list.ListResult, parents commonDatabaseModel) {
+			data := r.ReadFromAPI(ctx, &result.Diagnostics, parents.Uuid.ValueString(), postgresqlDatabaseResourceModel{commonDatabaseModel: parents})
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		})
+}
+
+func (r *mysqlDatabaseResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceSchema(ctx, &resp.Diagnostics,
+		"List Coolify MySQL databases to import, with the same filters as the `coolify_databases` data source.",
+		NewDatabasesDataSource(), "databases", databasesMostRecentField)
+}
+
+func (r *mysqlDatabaseResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = listDatabases(ctx, r.client, req, "standalone-mysql",
+		func(result *list.ListResult, parents commonDatabaseModel) {
+			data := r.ReadFromAPI(ctx, &result.Diagnostics, parents.Uuid.ValueString(), mysqlDatabaseResourceModel{commonDatabaseModel: parents})
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		})
+}
+
+// listDatabases lists the databases of the given type matching the list configuration.
+// Coolify does not return the server, project and environment of a database, so they are resolved from the inventory
+// and passed to read, which fills in the resource when Terraform asks for it.
+func listDatabases(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	req list.ListRequest,
+	databaseType string,
+	read func(result *list.ListResult, parents commonDatabaseModel),
+) iter.Seq[list.ListResult] {
+	var config filter.ListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	listResponse, err := client.ListDatabasesWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading databases", err.Error())
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	if listResponse.StatusCode() != http.StatusOK || listResponse.JSON200 == nil {
+		util.AddAPIError(&diags, "reading databases", listResponse.HTTPResponse, listResponse.Body, nil)
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	var databases []databaseDataSourceModel
+	matcher := filter.Compile(ctx, config.Filter)
+	for _, database := range *listResponse.JSON200 {
+		model, modelDiags := databaseDataSourceModel{}.FromAPI(&database)
+		diags.Append(modelDiags...)
+		if modelDiags.HasError() || model.DatabaseType.ValueString() != databaseType {
+			continue
+		}
+
+		if matcher.OnStruct(model) {
+			databases = append(databases, model)
+		}
+	}
+	if diags.HasError() {
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+	databases = filter.Sort(databases, databaseDataSourceModel.FilterAttributes, config.SortModel, databasesMostRecentField)
+
+	var servers map[string]inventoryServer
+	var environments map[int64]inventoryEnvironment
+	if len(databases) > 0 {
+		servers = serversByResource(listInventoryServers(ctx, client, &diags, inventoryConcurrency))
+		environments = environmentsById(listInventoryProjects(ctx, client, &diags, inventoryConcurrency))
+		if diags.HasError() {
+			return list.ListResultsStreamDiagnostics(diags)
+		}
+	}
+
+	return util.ListResults(ctx, req, databases, func(db databaseDataSourceModel, result *list.ListResult) {
+		result.DisplayName = db.Name.ValueString()
+
+		server, serverFound := servers[db.Uuid.ValueString()]
+		env, envFound := environments[db.EnvironmentId.ValueInt64()]
+		if !serverFound || !envFound {
+			result.Diagnostics.AddError(
+				"Unable to resolve database location",
+				fmt.Sprintf("Could not find the server, project and environment of database: uuid=%s", db.Uuid.ValueString()),
+			)
+			return
+		}
+
+		parents := commonDatabaseModel{
+			Uuid:            db.Uuid,
+			ServerUuid:      types.StringValue(server.Uuid),
+			ProjectUuid:     types.StringValue(env.ProjectUuid),
+			EnvironmentName: types.StringValue(env.Name),
+		}
+		result.Diagnostics.Append(result.Identity.Set(ctx, parents.Identity())...)
+
+		if req.IncludeResource {
+			read(result, parents)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestDatabaseListResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	for name, lr := range map[string]list.ListResource{
+		"postgresql": service.NewPostgresqlDatabaseListResource(),
+		"mysql":      service.NewMySQLDatabaseListResource(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &list.ListResourceSchemaResponse{}
+			lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, resp)
+
+			if _, ok := resp.Schema.Blocks["filter"].(schema.ListNestedBlock); !ok {
+				t.Error("filter should be a ListNestedBlock")
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var _ datasource.DataSource = &databasesDataSource{}
+var _ datasource.DataSourceWithConfigure = &databasesDataSource{}
+
+type databasesDataSourceModel struct {
+	Databases []databaseDataSourceModel `tfsdk:"databases"`
+	Filter    []filter.BlockModel       `tfsdk:"filter"`
+	filter.SortModel
+}
+
+func NewDatabasesDataSource() datasource.DataSource {
+	return &databasesDataSource{}
+}
+
+type databasesDataSource struct {
+	client *api.ClientWithResponses
+}
+
+func (d *databasesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_databases"
+}
+
+func (d *databasesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get a list of Coolify databases of all types.",
+		Attributes: map[string]schema.Attribute{
+			"databases": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: databaseDataSourceAttributes(),
+				},
+			},
+		},
+	}
+
+	makeDataSourceListFilterable(&resp.Schema, "databases", databasesMostRecentField)
+}
+
+func (d *databasesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	util.ProviderDataFromDataSourceConfigureRequest(req, &d.client, resp)
+}
+
+func (d *databasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan databasesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResponse, err := d.client.ListDatabasesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading databases", err.Error(),
+		)
+		return
+	}
+
+	if listResponse.StatusCode() != http.StatusOK || listResponse.JSON200 == nil {
+		util.AddAPIError(&resp.Diagnostics, "reading databases", listResponse.HTTPResponse, listResponse.Body, nil)
+		return
+	}
+
+	state, diag := d.apiToModel(ctx, listResponse.JSON200, plan.Filter, plan.SortModel)
+	resp.Diagnostics.Append(diag...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *databasesDataSource) apiToModel(
+	ctx context.Context,
+	databases *[]api.Database,
+	filters []filter.BlockModel,
+	sort filter.SortModel,
+) (databasesDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var databaseValues []databaseDataSourceModel
+
+	matcher := filter.Compile(ctx, filters)
+	for _, database := range *databases {
+		model, diag := databaseDataSourceModel{}.FromAPI(&database)
+		diags.Append(diag...)
+		if diag.HasError() {
+			continue
+		}
+
+		if !matcher.OnStruct(model) {
+			continue
+		}
+
+		databaseValues = append(databaseValues, model)
+	}
+
+	databaseValues = filter.Sort(databaseValues, databaseDataSourceModel.FilterAttributes, sort, databasesMostRecentField)
+
+	return databasesDataSourceModel{
+		Databases: databaseValues,
+		Filter:    filters,
+		SortModel: sort,
+	}, diags
+}
@@ -0,0 +1,79 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestAccDatabasesDataSource(t *testing.T) {
+	name := acctest.GetRandomResourceName("db")
+	resName := "data.coolify_databases.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseDataSourcePostgresqlConfig(name) + `
+				data "coolify_databases" "test" {
+					filter {
+						name   = "name"
+						values = [coolify_postgresql_database.test.name]
+					}
+					filter {
+						name   = "database_type"
+						values = ["standalone-postgresql"]
+					}
+					filter {
+						name   = "is_public"
+						values = ["false"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "databases.#", "1"),
+					resource.TestCheckResourceAttrPair(resName, "databases.0.uuid", "coolify_postgresql_database.test", "uuid"),
+					resource.TestCheckResourceAttr(resName, "databases.0.postgresql.postgres_db", "postgres"),
+				),
+			},
+			{
+				Config: testAccDatabaseDataSourcePostgresqlConfig(name) + `
+				data "coolify_databases" "test" {
+					filter {
+						name   = "name"
+						values = [coolify_postgresql_database.test.name]
+					}
+					filter {
+						name   = "database_type"
+						values = ["standalone-mysql"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "databases.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabasesDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := service.NewDatabasesDataSource()
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.Contains(t, resp.Schema.Blocks, "filter")
+	for _, attr := range []string{"sort_by", "order", "limit", "most_recent"} {
+		assert.Contains(t, resp.Schema.Attributes, attr)
+	}
+
+	nested := resp.Schema.Attributes["databases"].(schema.ListNestedAttribute).NestedObject.Attributes
+	assert.True(t, nested["uuid"].IsComputed())
+	assert.True(t, nested["internal_db_url"].IsSensitive())
+}
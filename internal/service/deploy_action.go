@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/expand"
+	"terraform-provider-coolify/internal/flatten"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ action.Action                   = &deployAction{}
+	_ action.ActionWithConfigure      = &deployAction{}
+	_ action.ActionWithValidateConfig = &deployAction{}
+)
+
+type deployAction struct {
+	client *api.ClientWithResponses
+}
+
+type deployActionModel struct {
+	Uuids   types.List  `tfsdk:"uuids"`
+	Tags    types.List  `tfsdk:"tags"`
+	Force   types.Bool  `tfsdk:"force"`
+	Wait    types.Bool  `tfsdk:"wait"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func NewDeployAction() action.Action {
+	return &deployAction{}
+}
+
+func (a *deployAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy"
+}
+
+func (a *deployAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Deploys Coolify resources by UUID or tag.",
+		MarkdownDescription: "Deploys Coolify resources by UUID or tag. The deployment logs are reported until every deployment is finished, unless `wait` is disabled.",
+		Attributes: map[string]schema.Attribute{
+			"uuids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				Description: "UUIDs of the resources to deploy.",
+			},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				Description: "Tags of the resources to deploy.",
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Rebuild without cache. Default: `false`.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait until the deployments are finished. Default: `true`.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+				Description: fmt.Sprintf("Maximum time to wait for all deployments in seconds. Default: `%d`.", consts.DEFAULT_ACTION_TIMEOUT),
+			},
+		},
+	}
+}
+
+func (a *deployAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config deployActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Uuids.IsNull() && config.Tags.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("uuids"),
+			"Missing deployment target",
+			"At least one of `uuids` or `tags` must be set.",
+		)
+	}
+}
+
+func (a *deployAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	util.ProviderDataFromActionConfigureRequest(req, &a.client, resp)
+}
+
+func (a *deployAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config deployActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	progress := actionProgress(ctx, resp)
+	params := &api.DeployByTagOrUuidParams{
+		Force: expand.Bool(config.Force),
+	}
+
+	var uuids, tags []string
+	resp.Diagnostics.Append(config.Uuids.ElementsAs(ctx, &uuids, false)...)
+	resp.Diagnostics.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(uuids) > 0 {
+		uuid := strings.Join(uuids, ",")
+		params.Uuid = &uuid
+	}
+	if len(tags) > 0 {
+		tag := strings.Join(tags, ",")
+		params.Tag = &tag
+	}
+
+	deployResp, err := a.client.DeployByTagOrUuidWithResponse(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deploying resources", err.Error())
+		return
+	}
+
+	if deployResp.StatusCode() != http.StatusOK || deployResp.JSON200 == nil {
+		util.AddAPIError(&resp.Diagnostics, "deploying resources", deployResp.HTTPResponse, deployResp.Body, nil)
+		return
+	}
+
+	var deploymentUuids []string
+	if deployResp.JSON200.Deployments != nil {
+		for _, deployment := range *deployResp.JSON200.Deployments {
+			progress(fmt.Sprintf("%s: %s",
+				flatten.String(deployment.ResourceUuid).ValueString(),
+				flatten.String(deployment.Message).ValueString()))
+			if deployment.DeploymentUuid != nil {
+				deploymentUuids = append(deploymentUuids, *deployment.DeploymentUuid)
+			}
+		}
+	}
+
+	if !config.Wait.IsNull() && !config.Wait.ValueBool() {
+		return
+	}
+
+	timeout := int64(consts.DEFAULT_ACTION_TIMEOUT)
+	if !config.Timeout.IsNull() {
+		timeout = config.Timeout.ValueInt64()
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	for _, uuid := range deploymentUuids {
+		waitForDeployment(ctx, a.client, &resp.Diagnostics, uuid, progress)
+	}
+}
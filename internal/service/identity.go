@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// uuidIdentityModel is the identity of resources that are identified by their UUID alone.
+type uuidIdentityModel struct {
+	Uuid types.String `tfsdk:"uuid"`
+}
+
+func uuidIdentitySchema(description string) identityschema.Schema {
+	return stringIdentitySchema("uuid", description)
+}
+
+// serverIdentityModel is the identity of resources that are part of a server, such as its settings.
+type serverIdentityModel struct {
+	ServerUuid types.String `tfsdk:"server_uuid"`
+}
+
+// applicationIdentityModel is the identity of resources that are part of an application, such as its environment variables.
+type applicationIdentityModel struct {
+	ApplicationUuid types.String `tfsdk:"application_uuid"`
+}
+
+// serviceIdentityModel is the identity of resources that are part of a service, such as its environment variables.
+type serviceIdentityModel struct {
+	ServiceUuid types.String `tfsdk:"service_uuid"`
+}
+
+// stringIdentitySchema creates an identity schema with a single string attribute.
+func stringIdentitySchema(name string, description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			name: identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       description,
+			},
+		},
+	}
+}
+
+// databaseIdentityModel is the identity of databases.
+// Coolify does not return the server, project and environment of a database, so they are part of its identity.
+type databaseIdentityModel struct {
+	Uuid            types.String `tfsdk:"uuid"`
+	ServerUuid      types.String `tfsdk:"server_uuid"`
+	ProjectUuid     types.String `tfsdk:"project_uuid"`
+	EnvironmentName types.String `tfsdk:"environment_name"`
+}
+
+func databaseIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"uuid": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "UUID of the database.",
+			},
+			"server_uuid": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "UUID of the server.",
+			},
+			"project_uuid": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "UUID of the project.",
+			},
+			"environment_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the environment.",
+			},
+		},
+	}
+}
+
+func (m commonDatabaseModel) Identity() databaseIdentityModel {
+	return databaseIdentityModel{
+		Uuid:            m.Uuid,
+		ServerUuid:      m.ServerUuid,
+		ProjectUuid:     m.ProjectUuid,
+		EnvironmentName: m.EnvironmentName,
+	}
+}
+
+// importDatabaseState imports a database by its identity, or by an ID in the format `<server_uuid>/<project_uuid>/<environment_name>/<database_uuid>`.
+func importDatabaseState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity databaseIdentityModel
+	if req.ID != "" {
+		ids := strings.Split(req.ID, "/")
+		if len(ids) != 4 {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"Import ID should be in the format: <server_uuid>/<project_uuid>/<environment_name>/<database_uuid>",
+			)
+			return
+		}
+
+		identity = databaseIdentityModel{
+			ServerUuid:      types.StringValue(ids[0]),
+			ProjectUuid:     types.StringValue(ids[1]),
+			EnvironmentName: types.StringValue(ids[2]),
+			Uuid:            types.StringValue(ids[3]),
+		}
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_uuid"), identity.ServerUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), identity.ProjectUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_name"), identity.EnvironmentName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), identity.Uuid)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
@@ -0,0 +1,57 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+
+	"terraform-provider-coolify/internal/service"
+	"terraform-provider-coolify/internal/service/private_key"
+)
+
+func TestResourceIdentitySchemas(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		resource   tfresource.Resource
+		attributes []string
+	}{
+		"private_key":         {private_key.NewPrivateKeyResource(), []string{"uuid"}},
+		"server":              {service.NewServerResource(), []string{"uuid"}},
+		"server_settings":     {service.NewServerSettingsResource(), []string{"server_uuid"}},
+		"server_log_drain":    {service.NewServerLogDrainResource(), []string{"server_uuid"}},
+		"project":             {service.NewProjectResource(), []string{"uuid"}},
+		"application_envs":    {service.NewApplicationEnvsResource(), []string{"application_uuid"}},
+		"application_swarm":   {service.NewApplicationSwarmResource(), []string{"application_uuid"}},
+		"service_envs":        {service.NewServiceEnvsResource(), []string{"service_uuid"}},
+		"postgresql_database": {service.NewPostgresqlDatabaseResource(), []string{"uuid", "server_uuid", "project_uuid", "environment_name"}},
+		"mysql_database":      {service.NewMySQLDatabaseResource(), []string{"uuid", "server_uuid", "project_uuid", "environment_name"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rs, ok := tt.resource.(tfresource.ResourceWithIdentity)
+			if !ok {
+				t.Fatal("resource should implement ResourceWithIdentity")
+			}
+
+			resp := &tfresource.IdentitySchemaResponse{}
+			rs.IdentitySchema(ctx, tfresource.IdentitySchemaRequest{}, resp)
+
+			if len(resp.IdentitySchema.Attributes) != len(tt.attributes) {
+				t.Errorf("expected %d identity attributes, got %d", len(tt.attributes), len(resp.IdentitySchema.Attributes))
+			}
+			for _, attr := range tt.attributes {
+				identityAttr, ok := resp.IdentitySchema.Attributes[attr].(identityschema.StringAttribute)
+				if !ok {
+					t.Errorf("identity attribute %q should be a string", attr)
+					continue
+				}
+				if !identityAttr.RequiredForImport {
+					t.Errorf("identity attribute %q should be required for import", attr)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var _ datasource.DataSource = &inventoryDataSource{}
+var _ datasource.DataSourceWithConfigure = &inventoryDataSource{}
+
+type inventoryDataSourceModel struct {
+	Concurrency     types.Int64                             `tfsdk:"concurrency"`
+	Servers         []inventoryServerModel                  `tfsdk:"servers"`
+	ServersByName   map[string]inventoryServerSummaryModel  `tfsdk:"servers_by_name"`
+	ServersByUuid   map[string]inventoryServerSummaryModel  `tfsdk:"servers_by_uuid"`
+	ProjectsByName  map[string]inventoryProjectSummaryModel `tfsdk:"projects_by_name"`
+	ProjectsByUuid  map[string]inventoryProjectSummaryModel `tfsdk:"projects_by_uuid"`
+	ResourcesByName map[string]resourceModel                `tfsdk:"resources_by_name"`
+	ResourcesByUuid map[string]resourceModel                `tfsdk:"resources_by_uuid"`
+}
+
+type inventoryServerModel struct {
+	Ip       types.String            `tfsdk:"ip"`
+	Name     types.String            `tfsdk:"name"`
+	Projects []inventoryProjectModel `tfsdk:"projects"`
+	Uuid     types.String            `tfsdk:"uuid"`
+}
+
+type inventoryProjectModel struct {
+	Description  types.String                `tfsdk:"description"`
+	Environments []inventoryEnvironmentModel `tfsdk:"environments"`
+	Name         types.String                `tfsdk:"name"`
+	Uuid         types.String                `tfsdk:"uuid"`
+}
+
+type inventoryEnvironmentModel struct {
+	Id        types.Int64     `tfsdk:"id"`
+	Name      types.String    `tfsdk:"name"`
+	Resources []resourceModel `tfsdk:"resources"`
+}
+
+type inventoryServerSummaryModel struct {
+	Ip   types.String `tfsdk:"ip"`
+	Name types.String `tfsdk:"name"`
+	Uuid types.String `tfsdk:"uuid"`
+}
+
+type inventoryProjectSummaryModel struct {
+	Description  types.String           `tfsdk:"description"`
+	Environments map[string]types.Int64 `tfsdk:"environments"`
+	Name         types.String           `tfsdk:"name"`
+	Uuid         types.String           `tfsdk:"uuid"`
+}
+
+func NewInventoryDataSource() datasource.DataSource {
+	return &inventoryDataSource{}
+}
+
+type inventoryDataSource struct {
+	client *api.ClientWithResponses
+}
+
+func (d *inventoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory"
+}
+
+func (d *inventoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	serverSummary := map[string]schema.Attribute{
+		"ip":   schema.StringAttribute{Computed: true, Description: "The IP address of the server."},
+		"name": schema.StringAttribute{Computed: true, Description: "The name of the server."},
+		"uuid": schema.StringAttribute{Computed: true, Description: "The UUID of the server."},
+	}
+	projectSummary := map[string]schema.Attribute{
+		"description": schema.StringAttribute{Computed: true, Description: "The description of the project."},
+		"environments": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: "The identifiers of the environments of the project, keyed by name.",
+		},
+		"name": schema.StringAttribute{Computed: true, Description: "The name of the project."},
+		"uuid": schema.StringAttribute{Computed: true, Description: "The UUID of the project."},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Get the servers, projects, environments and resources of the team as a single graph, with lookup maps keyed by name and UUID." +
+			"\nNOTE: Building the graph requires an additional API call per server and per project, which are made concurrently.",
+		Attributes: map[string]schema.Attribute{
+			"concurrency": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum number of concurrent API calls. Defaults to `%d`.", inventoryConcurrency),
+				Validators:  []validator.Int64{int64validator.Between(1, 32)},
+			},
+			"servers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The servers, with the projects, environments and resources deployed to them. A project is listed under every server it has resources on.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip":   schema.StringAttribute{Computed: true, Description: "The IP address of the server."},
+						"name": schema.StringAttribute{Computed: true, Description: "The name of the server."},
+						"uuid": schema.StringAttribute{Computed: true, Description: "The UUID of the server."},
+						"projects": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The projects with resources on the server.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"description": schema.StringAttribute{Computed: true, Description: "The description of the project."},
+									"name":        schema.StringAttribute{Computed: true, Description: "The name of the project."},
+									"uuid":        schema.StringAttribute{Computed: true, Description: "The UUID of the project."},
+									"environments": schema.ListNestedAttribute{
+										Computed:    true,
+										Description: "The environments with resources on the server.",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"id":   schema.Int64Attribute{Computed: true, Description: "The identifier of the environment."},
+												"name": schema.StringAttribute{Computed: true, Description: "The name of the environment."},
+												"resources": schema.ListNestedAttribute{
+													Computed:     true,
+													Description:  "The resources of the environment on the server.",
+													NestedObject: schema.NestedAttributeObject{Attributes: resourceAttributes()},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"servers_by_name": schema.MapNestedAttribute{
+				Computed:     true,
+				Description:  "The servers keyed by name. Coolify does not enforce unique names, so when names collide only the first server listed is kept.",
+				NestedObject: schema.NestedAttributeObject{Attributes: serverSummary},
+			},
+			"servers_by_uuid": schema.MapNestedAttribute{
+				Computed:     true,
+				Description:  "The servers keyed by UUID.",
+				NestedObject: schema.NestedAttributeObject{Attributes: serverSummary},
+			},
+			"projects_by_name": schema.MapNestedAttribute{
+				Computed:     true,
+				Description:  "The projects keyed by name. Coolify does not enforce unique names, so when names collide only the first project listed is kept.",
+				NestedObject: schema.NestedAttributeObject{Attributes: projectSummary},
+			},
+			"projects_by_uuid": schema.MapNestedAttribute{
+				Computed:     true,
+				Description:  "The projects keyed by UUID.",
+				NestedObject: schema.NestedAttributeObject{Attributes: projectSummary},
+			},
+			"resources_by_name": schema.MapNestedAttribute{
+				Computed:     true,
+				Description:  "The resources keyed by `<project name>/<environment name>/<resource name>`. Coolify does not enforce unique names, so when names collide only the first resource listed is kept.",
+				NestedObject: schema.NestedAttributeObject{Attributes: resourceAttributes()},
+			},
+			"resources_by_uuid": schema.MapNestedAttribute{
+				Computed:     true,
+				Description:  "The resources keyed by UUID, including resources whose server could not be resolved.",
+				NestedObject: schema.NestedAttributeObject{Attributes: resourceAttributes()},
+			},
+		},
+	}
+}
+
+func (d *inventoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	util.ProviderDataFromDataSourceConfigureRequest(req, &d.client, resp)
+}
+
+func (d *inventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan inventoryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workers := inventoryConcurrency
+	if !plan.Concurrency.IsNull() {
+		workers = plan.Concurrency.ValueInt64()
+	}
+
+	// The calls for each server and project are made concurrently, bounded by `concurrency`
+	resources := listInventoryResources(ctx, d.client, &resp.Diagnostics)
+	servers := listInventoryServers(ctx, d.client, &resp.Diagnostics, workers)
+	projects := listInventoryProjects(ctx, d.client, &resp.Diagnostics, workers)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := buildInventory(&resp.Diagnostics, resources, servers, projects)
+	state.Concurrency = plan.Concurrency
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// buildInventory nests the resources under their server, project and environment, and indexes everything by name and UUID.
+// Coolify does not enforce unique names, so when names collide the first entry listed is kept and a warning is added.
+func buildInventory(
+	diags *diag.Diagnostics,
+	resources []inventoryResource,
+	servers []inventoryServer,
+	projects []inventoryProject,
+) inventoryDataSourceModel {
+	serverByResource := serversByResource(servers)
+	environments := environmentsById(projects)
+
+	state := inventoryDataSourceModel{
+		Servers:         []inventoryServerModel{},
+		ServersByName:   map[string]inventoryServerSummaryModel{},
+		ServersByUuid:   map[string]inventoryServerSummaryModel{},
+		ProjectsByName:  map[string]inventoryProjectSummaryModel{},
+		ProjectsByUuid:  map[string]inventoryProjectSummaryModel{},
+		ResourcesByName: map[string]resourceModel{},
+		ResourcesByUuid: map[string]resourceModel{},
+	}
+
+	for _, project := range projects {
+		summary := inventoryProjectSummaryModel{
+			Description:  project.Description,
+			Environments: map[string]types.Int64{},
+			Name:         types.StringValue(project.Name),
+			Uuid:         types.StringValue(project.Uuid),
+		}
+		for _, env := range project.Environments {
+			summary.Environments[env.Name] = types.Int64Value(env.Id)
+		}
+		if _, ok := state.ProjectsByName[project.Name]; ok {
+			addInventoryNameCollision(diags, "projects_by_name", project.Name, project.Uuid)
+		} else {
+			state.ProjectsByName[project.Name] = summary
+		}
+		state.ProjectsByUuid[project.Uuid] = summary
+	}
+
+	// Group the resources by server and environment, keeping the order they were listed in
+	byServerAndEnvironment := map[string]map[int64][]resourceModel{}
+	for _, res := range resources {
+		model := resourceModel{}.FromInventory(res, serverByResource, environments)
+
+		state.ResourcesByUuid[model.Uuid.ValueString()] = model
+		if !model.ProjectName.IsNull() {
+			key := fmt.Sprintf("%s/%s/%s", model.ProjectName.ValueString(), model.EnvironmentName.ValueString(), model.Name.ValueString())
+			if _, ok := state.ResourcesByName[key]; ok {
+				addInventoryNameCollision(diags, "resources_by_name", key, model.Uuid.ValueString())
+			} else {
+				state.ResourcesByName[key] = model
+			}
+		}
+
+		if model.ServerUuid.IsNull() || model.EnvironmentId.IsNull() {
+			continue
+		}
+
+		serverUuid, envId := model.ServerUuid.ValueString(), model.EnvironmentId.ValueInt64()
+		if byServerAndEnvironment[serverUuid] == nil {
+			byServerAndEnvironment[serverUuid] = map[int64][]resourceModel{}
+		}
+		byServerAndEnvironment[serverUuid][envId] = append(byServerAndEnvironment[serverUuid][envId], model)
+	}
+
+	for _, server := range servers {
+		summary := inventoryServerSummaryModel{
+			Ip:   types.StringValue(server.Ip),
+			Name: types.StringValue(server.Name),
+			Uuid: types.StringValue(server.Uuid),
+		}
+		if _, ok := state.ServersByName[server.Name]; ok {
+			addInventoryNameCollision(diags, "servers_by_name", server.Name, server.Uuid)
+		} else {
+			state.ServersByName[server.Name] = summary
+		}
+		state.ServersByUuid[server.Uuid] = summary
+
+		serverModel := inventoryServerModel{
+			Ip:       summary.Ip,
+			Name:     summary.Name,
+			Projects: []inventoryProjectModel{},
+			Uuid:     summary.Uuid,
+		}
+
+		for _, project := range projects {
+			var envModels []inventoryEnvironmentModel
+			for _, env := range project.Environments {
+				if envResources, ok := byServerAndEnvironment[server.Uuid][env.Id]; ok {
+					envModels = append(envModels, inventoryEnvironmentModel{
+						Id:        types.Int64Value(env.Id),
+						Name:      types.StringValue(env.Name),
+						Resources: envResources,
+					})
+				}
+			}
+
+			if len(envModels) == 0 {
+				continue
+			}
+
+			serverModel.Projects = append(serverModel.Projects, inventoryProjectModel{
+				Description:  project.Description,
+				Environments: envModels,
+				Name:         types.StringValue(project.Name),
+				Uuid:         types.StringValue(project.Uuid),
+			})
+		}
+
+		state.Servers = append(state.Servers, serverModel)
+	}
+
+	return state
+}
+
+func addInventoryNameCollision(diags *diag.Diagnostics, attribute, name, uuid string) {
+	diags.AddWarning(
+		fmt.Sprintf("Duplicate name in %s", attribute),
+		fmt.Sprintf("More than one entry is named %q, only the first one listed is kept in `%s`. Use the `_by_uuid` map to look up uuid=%s.", name, attribute, uuid),
+	)
+}
@@ -0,0 +1,54 @@
+package service_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestAccInventoryDataSource(t *testing.T) {
+	resName := "data.coolify_inventory.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "coolify_inventory" "test" {
+					concurrency = 2
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "servers_by_uuid."+acctest.ServerUUID+".uuid", acctest.ServerUUID),
+					resource.TestCheckResourceAttr(resName, "projects_by_uuid."+acctest.ProjectUUID+".uuid", acctest.ProjectUUID),
+					resource.TestCheckResourceAttr(resName, "resources_by_uuid."+acctest.ApplicationUUID+".server_uuid", acctest.ServerUUID),
+					resource.TestCheckResourceAttr(resName, "resources_by_uuid."+acctest.ApplicationUUID+".environment_name", acctest.EnvironmentName),
+					resource.TestCheckResourceAttrSet(resName, "servers.0.projects.0.environments.0.resources.0.uuid"),
+				),
+			},
+			{
+				Config: `data "coolify_inventory" "test" {
+					concurrency = 0
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+func TestInventoryDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := service.NewInventoryDataSource()
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.True(t, resp.Schema.Attributes["concurrency"].IsOptional())
+	for _, attr := range []string{"servers", "servers_by_name", "servers_by_uuid", "projects_by_name", "projects_by_uuid", "resources_by_name", "resources_by_uuid"} {
+		assert.True(t, resp.Schema.Attributes[attr].IsComputed(), "%s should be computed", attr)
+	}
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ action.Action              = &lifecycleAction{}
+	_ action.ActionWithConfigure = &lifecycleAction{}
+)
+
+// restartGracePeriod is the time a restart has to show a status other than running.
+// A restart faster than the poll interval, or a status Coolify only refreshes periodically, never shows one,
+// so the resource is considered restarted once it is still running after this period.
+var restartGracePeriod = 60 * time.Second
+
+// apiCall calls a Coolify endpoint for the resource with the given UUID and returns the raw response.
+type apiCall func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error)
+
+// lifecycleTarget describes how to start, stop, restart and read a kind of resource.
+type lifecycleTarget struct {
+	Name    string
+	Start   apiCall
+	Stop    apiCall
+	Restart apiCall
+	Read    apiCall
+}
+
+var applicationLifecycle = lifecycleTarget{
+	Name: "application",
+	Start: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.StartApplicationByUuidWithResponse(ctx, uuid, &api.StartApplicationByUuidParams{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Stop: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.StopApplicationByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Restart: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.RestartApplicationByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Read: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.GetApplicationByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+}
+
+var databaseLifecycle = lifecycleTarget{
+	Name: "database",
+	Start: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.StartDatabaseByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Stop: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.StopDatabaseByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Restart: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.RestartDatabaseByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Read: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.GetDatabaseByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+}
+
+var serviceLifecycle = lifecycleTarget{
+	Name: "service",
+	Start: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.StartServiceByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Stop: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.StopServiceByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Restart: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.RestartServiceByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+	Read: func(ctx context.Context, client *api.ClientWithResponses, uuid string) (*http.Response, []byte, error) {
+		resp, err := client.GetServiceByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	},
+}
+
+type lifecycleAction struct {
+	client *api.ClientWithResponses
+	target lifecycleTarget
+}
+
+type lifecycleActionModel struct {
+	Uuid      types.String `tfsdk:"uuid"`
+	Operation types.String `tfsdk:"operation"`
+	Wait      types.Bool   `tfsdk:"wait"`
+	Timeout   types.Int64  `tfsdk:"timeout"`
+}
+
+func NewApplicationLifecycleAction() action.Action {
+	return &lifecycleAction{target: applicationLifecycle}
+}
+
+func NewDatabaseLifecycleAction() action.Action {
+	return &lifecycleAction{target: databaseLifecycle}
+}
+
+func NewServiceLifecycleAction() action.Action {
+	return &lifecycleAction{target: serviceLifecycle}
+}
+
+func (a *lifecycleAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + a.target.Name + "_lifecycle"
+}
+
+func (a *lifecycleAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Starts, stops or restarts a Coolify %s.", a.target.Name),
+		MarkdownDescription: fmt.Sprintf(
+			"Starts, stops or restarts a Coolify %s. Progress is reported until the %s reaches the expected status, unless `wait` is disabled.",
+			a.target.Name, a.target.Name),
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("UUID of the %s.", a.target.Name),
+			},
+			"operation": schema.StringAttribute{
+				Required:    true,
+				Description: "Operation to perform, one of `start`, `stop` or `restart`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("start", "stop", "restart"),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Wait until the %s is running, or exited when it is stopped. A restart is followed until the status leaves running and comes back, or is still running after %d seconds. Default: `true`.",
+					a.target.Name, int64(restartGracePeriod/time.Second)),
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+				Description: fmt.Sprintf("Maximum time to wait in seconds. Default: `%d`.", consts.DEFAULT_ACTION_TIMEOUT),
+			},
+		},
+	}
+}
+
+func (a *lifecycleAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	util.ProviderDataFromActionConfigureRequest(req, &a.client, resp)
+}
+
+func (a *lifecycleAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config lifecycleActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	progress := actionProgress(ctx, resp)
+	uuid := config.Uuid.ValueString()
+	operation := config.Operation.ValueString()
+
+	var call apiCall
+	var verb string
+	switch operation {
+	case "start":
+		call, verb = a.target.Start, "starting"
+	case "stop":
+		call, verb = a.target.Stop, "stopping"
+	default:
+		call, verb = a.target.Restart, "restarting"
+	}
+
+	progress(fmt.Sprintf("%s %s %s", strings.ToUpper(verb[:1])+verb[1:], a.target.Name, uuid))
+	httpResp, body, err := call(ctx, a.client, uuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error %s %s: uuid=%s", verb, a.target.Name, uuid),
+			err.Error(),
+		)
+		return
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("%s %s: uuid=%s", verb, a.target.Name, uuid), httpResp, body, nil)
+		return
+	}
+
+	if message := messageFromBody(body); message != "" {
+		progress(message)
+	}
+
+	if !config.Wait.IsNull() && !config.Wait.ValueBool() {
+		return
+	}
+
+	timeout := int64(consts.DEFAULT_ACTION_TIMEOUT)
+	if !config.Timeout.IsNull() {
+		timeout = config.Timeout.ValueInt64()
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	// Starting or restarting an application queues a deployment, which is followed instead of the status
+	if deploymentUuid := deploymentUuidFromBody(body); deploymentUuid != "" {
+		waitForDeployment(ctx, a.client, &resp.Diagnostics, deploymentUuid, progress)
+		return
+	}
+
+	description := fmt.Sprintf("The %s %s", a.target.Name, uuid)
+	read := func(ctx context.Context) (string, error) {
+		readResp, body, err := a.target.Read(ctx, a.client, uuid)
+		if err != nil {
+			return "", err
+		}
+		if readResp.StatusCode != http.StatusOK {
+			return "", api.DecodeError(readResp, body)
+		}
+		return statusFromBody(body), nil
+	}
+
+	switch operation {
+	case "stop":
+		waitForStatus(ctx, &resp.Diagnostics, description, read, isExited, progress)
+	case "restart":
+		// The status is still running until the restart takes effect, so it has to go down before it comes back up
+		graceCtx, graceCancel := context.WithTimeout(ctx, restartGracePeriod)
+		var graceDiags diag.Diagnostics
+		waitForStatus(graceCtx, &graceDiags, description, read, func(status string) bool { return !isRunning(status) }, progress)
+		graceCancel()
+		if graceDiags.HasError() {
+			if ctx.Err() != nil {
+				resp.Diagnostics.Append(graceDiags...)
+				return
+			}
+			progress(fmt.Sprintf("%s did not leave running within %d seconds, assuming it restarted", description, int64(restartGracePeriod/time.Second)))
+		}
+		waitForStatus(ctx, &resp.Diagnostics, description, read, isRunning, progress)
+	default:
+		waitForStatus(ctx, &resp.Diagnostics, description, read, isRunning, progress)
+	}
+}
+
+func isRunning(status string) bool {
+	return strings.HasPrefix(status, "running")
+}
+
+func isExited(status string) bool {
+	return strings.HasPrefix(status, "exited")
+}
@@ -0,0 +1,46 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestActionSchemas(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		action     action.Action
+		attributes []string
+	}{
+		"coolify_application_lifecycle":       {service.NewApplicationLifecycleAction(), []string{"uuid", "operation", "wait", "timeout"}},
+		"coolify_database_lifecycle":          {service.NewDatabaseLifecycleAction(), []string{"uuid", "operation", "wait", "timeout"}},
+		"coolify_service_lifecycle":           {service.NewServiceLifecycleAction(), []string{"uuid", "operation", "wait", "timeout"}},
+		"coolify_deploy":                      {service.NewDeployAction(), []string{"uuids", "tags", "force", "wait", "timeout"}},
+		"coolify_server_validate":             {service.NewServerValidateAction(), []string{"uuid", "wait", "timeout"}},
+		"coolify_application_execute_command": {service.NewApplicationExecuteCommandAction(), []string{"uuid", "command"}},
+	}
+
+	for typeName, tt := range tests {
+		t.Run(typeName, func(t *testing.T) {
+			metadataResp := &action.MetadataResponse{}
+			tt.action.Metadata(ctx, action.MetadataRequest{ProviderTypeName: "coolify"}, metadataResp)
+			if metadataResp.TypeName != typeName {
+				t.Errorf("expected type name %q, got %q", typeName, metadataResp.TypeName)
+			}
+
+			resp := &action.SchemaResponse{}
+			tt.action.Schema(ctx, action.SchemaRequest{}, resp)
+			if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Errorf("schema should be valid: %v", diags)
+			}
+			for _, attr := range tt.attributes {
+				if _, ok := resp.Schema.Attributes[attr]; !ok {
+					t.Errorf("attribute %q should exist in schema", attr)
+				}
+			}
+		})
+	}
+}
@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +21,7 @@ var (
 	_ resource.ResourceWithConfigure   = &mysqlDatabaseResource{}
 	_ resource.ResourceWithImportState = &mysqlDatabaseResource{}
 	_ resource.ResourceWithModifyPlan  = &mysqlDatabaseResource{}
+	_ resource.ResourceWithIdentity    = &mysqlDatabaseResource{}
 )
 
 type mysqlDatabaseResourceModel = mysqlDatabaseModel
@@ -72,6 +71,10 @@ func (r *mysqlDatabaseResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = mergeResourceSchemas(commonSchema, mysqlSchema)
 }
 
+func (r *mysqlDatabaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = databaseIdentitySchema()
+}
+
 func (r *mysqlDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
 }
@@ -88,50 +91,48 @@ func (r *mysqlDatabaseResource) Create(ctx context.Context, req resource.CreateR
 		"name": plan.Name.ValueString(),
 	})
 
-	createResp, err := r.client.CreateDatabaseMysqlWithResponse(ctx, api.CreateDatabaseMysqlJSONRequestBody{
-		Description:             plan.Description.ValueStringPointer(),
-		Name:                    plan.Name.ValueStringPointer(),
-		DestinationUuid:         plan.DestinationUuid.ValueStringPointer(),
-		EnvironmentName:         plan.EnvironmentName.ValueString(),
-		EnvironmentUuid:         plan.EnvironmentUuid.ValueString(),
-		Image:                   plan.Image.ValueStringPointer(),
-		InstantDeploy:           plan.InstantDeploy.ValueBoolPointer(),
-		IsPublic:                plan.IsPublic.ValueBoolPointer(),
-		LimitsCpuShares:         expand.Int64(plan.LimitsCpuShares),
-		LimitsCpus:              plan.LimitsCpus.ValueStringPointer(),
-		LimitsCpuset:            plan.LimitsCpuset.ValueStringPointer(),
-		LimitsMemory:            plan.LimitsMemory.ValueStringPointer(),
-		LimitsMemoryReservation: plan.LimitsMemoryReservation.ValueStringPointer(),
-		LimitsMemorySwap:        plan.LimitsMemorySwap.ValueStringPointer(),
-		LimitsMemorySwappiness:  expand.Int64(plan.LimitsMemorySwappiness),
-		MysqlConf:               base64EncodeAttr(plan.MysqlConf),
-		MysqlDatabase:           plan.MysqlDatabase.ValueStringPointer(),
-		MysqlPassword:           expand.String(plan.MysqlPassword),
-		MysqlRootPassword:       expand.String(plan.MysqlRootPassword),
-		MysqlUser:               plan.MysqlUser.ValueStringPointer(),
-		ProjectUuid:             plan.ProjectUuid.ValueString(),
-		PublicPort:              expand.Int64(plan.PublicPort),
-		ServerUuid:              plan.ServerUuid.ValueString(),
+	target := databaseCreateTarget("standalone-mysql", "MySQL database", plan.commonDatabaseModel)
+	uuid := createReconciled(ctx, r.client, &resp.Diagnostics, target, plan, func() (string, *http.Response, []byte, error) {
+		createResp, err := r.client.CreateDatabaseMysqlWithResponse(ctx, api.CreateDatabaseMysqlJSONRequestBody{
+			Description:             plan.Description.ValueStringPointer(),
+			Name:                    plan.Name.ValueStringPointer(),
+			DestinationUuid:         plan.DestinationUuid.ValueStringPointer(),
+			EnvironmentName:         plan.EnvironmentName.ValueString(),
+			EnvironmentUuid:         plan.EnvironmentUuid.ValueString(),
+			Image:                   plan.Image.ValueStringPointer(),
+			InstantDeploy:           plan.InstantDeploy.ValueBoolPointer(),
+			IsPublic:                plan.IsPublic.ValueBoolPointer(),
+			LimitsCpuShares:         expand.Int64(plan.LimitsCpuShares),
+			LimitsCpus:              plan.LimitsCpus.ValueStringPointer(),
+			LimitsCpuset:            plan.LimitsCpuset.ValueStringPointer(),
+			LimitsMemory:            plan.LimitsMemory.ValueStringPointer(),
+			LimitsMemoryReservation: plan.LimitsMemoryReservation.ValueStringPointer(),
+			LimitsMemorySwap:        plan.LimitsMemorySwap.ValueStringPointer(),
+			LimitsMemorySwappiness:  expand.Int64(plan.LimitsMemorySwappiness),
+			MysqlConf:               base64EncodeAttr(plan.MysqlConf),
+			MysqlDatabase:           plan.MysqlDatabase.ValueStringPointer(),
+			MysqlPassword:           expand.String(plan.MysqlPassword),
+			MysqlRootPassword:       expand.String(plan.MysqlRootPassword),
+			MysqlUser:               plan.MysqlUser.ValueStringPointer(),
+			ProjectUuid:             plan.ProjectUuid.ValueString(),
+			PublicPort:              expand.Int64(plan.PublicPort),
+			ServerUuid:              plan.ServerUuid.ValueString(),
+		})
+		if err != nil {
+			return "", nil, nil, err
+		}
+		if createResp.StatusCode() != http.StatusCreated || createResp.JSON201 == nil {
+			return "", createResp.HTTPResponse, createResp.Body, nil
+		}
+		return createResp.JSON201.Uuid, createResp.HTTPResponse, createResp.Body, nil
 	})
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating MySQL database",
-			err.Error(),
-		)
-		return
-	}
-
-	if createResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating MySQL database",
-			fmt.Sprintf("Received %s creating MySQL database. Details: %s", createResp.Status(), createResp.Body),
-		)
+	if uuid == "" {
 		return
 	}
 
-	data := r.ReadFromAPI(ctx, &resp.Diagnostics, createResp.JSON201.Uuid, plan)
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, uuid, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *mysqlDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -151,7 +152,11 @@ func (r *mysqlDatabaseResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, state.Uuid.ValueString(), state)
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *mysqlDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -203,9 +208,7 @@ func (r *mysqlDatabaseResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	if updateResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code updating MySQL database",
-			fmt.Sprintf("Received %s updating MySQL database: uuid=%s. Details: %s", updateResp.Status(), uuid, updateResp.Body))
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("updating MySQL database: uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, plan)
 		return
 	}
 
@@ -215,6 +218,7 @@ func (r *mysqlDatabaseResource) Update(ctx context.Context, req resource.UpdateR
 
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, uuid, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *mysqlDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -242,30 +246,14 @@ func (r *mysqlDatabaseResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	if deleteResp.JSON200 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting MySQL database",
-			fmt.Sprintf("Received %s deleting MySQL database: %s. Details: %s", deleteResp.Status(), state, deleteResp.Body))
+	if deleteResp.JSON200 == nil && deleteResp.StatusCode() != http.StatusNotFound {
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("deleting MySQL database: uuid=%s", state.Uuid.ValueString()), deleteResp.HTTPResponse, deleteResp.Body, nil)
 		return
 	}
 }
 
 func (r *mysqlDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ids := strings.Split(req.ID, "/")
-	if len(ids) != 4 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			"Import ID should be in the format: <server_uuid>/<project_uuid>/<environment_name>/<database_uuid>",
-		)
-		return
-	}
-
-	serverUuid, projectUuid, environmentName, uuid := ids[0], ids[1], ids[2], ids[3]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_uuid"), serverUuid)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUuid)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_name"), environmentName)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), uuid)...)
+	importDatabaseState(ctx, req, resp)
 }
 
 func (r *mysqlDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -304,9 +292,7 @@ func (r *mysqlDatabaseResource) ReadFromAPI(
 	}
 
 	if readResp.StatusCode() != http.StatusOK {
-		diags.AddError(
-			"Unexpected HTTP status code reading MySQL database",
-			fmt.Sprintf("Received %s for MySQL database: uuid=%s. Details: %s", readResp.Status(), uuid, readResp.Body))
+		util.AddAPIError(diags, fmt.Sprintf("reading MySQL database: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
 		return mysqlDatabaseResourceModel{}
 	}
 
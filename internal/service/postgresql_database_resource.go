@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,6 +20,7 @@ var (
 	_ resource.ResourceWithConfigure   = &postgresqlDatabaseResource{}
 	_ resource.ResourceWithImportState = &postgresqlDatabaseResource{}
 	_ resource.ResourceWithModifyPlan  = &postgresqlDatabaseResource{}
+	_ resource.ResourceWithIdentity    = &postgresqlDatabaseResource{}
 )
 
 type postgresqlDatabaseResourceModel = postgresqlDatabaseModel
@@ -74,6 +73,10 @@ func (r *postgresqlDatabaseResource) Schema(ctx context.Context, req resource.Sc
 	resp.Schema = mergeResourceSchemas(commonSchema, postgresqlSchema)
 }
 
+func (r *postgresqlDatabaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = databaseIdentitySchema()
+}
+
 func (r *postgresqlDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
 }
@@ -90,69 +93,67 @@ func (r *postgresqlDatabaseResource) Create(ctx context.Context, req resource.Cr
 		"name": plan.Name.ValueString(),
 	})
 
-	createResp, err := r.client.CreateDatabasePostgresqlWithResponse(ctx, api.CreateDatabasePostgresqlJSONRequestBody{
-		Description:     plan.Description.ValueStringPointer(),
-		Name:            plan.Name.ValueStringPointer(),
-		DestinationUuid: plan.DestinationUuid.ValueStringPointer(),
-		EnvironmentName: plan.EnvironmentName.ValueString(),
-		EnvironmentUuid: plan.EnvironmentUuid.ValueString(),
-		Image:           plan.Image.ValueStringPointer(),
-		InstantDeploy:   plan.InstantDeploy.ValueBoolPointer(),
-		IsPublic:        plan.IsPublic.ValueBoolPointer(),
-		LimitsCpuShares: func() *int {
-			if plan.LimitsCpuShares.IsUnknown() || plan.LimitsCpuShares.IsNull() {
-				return nil
-			}
-			value := int(*plan.LimitsCpuShares.ValueInt64Pointer())
-			return &value
-		}(),
-		LimitsCpus:              plan.LimitsCpus.ValueStringPointer(),
-		LimitsCpuset:            plan.LimitsCpuset.ValueStringPointer(),
-		LimitsMemory:            plan.LimitsMemory.ValueStringPointer(),
-		LimitsMemoryReservation: plan.LimitsMemoryReservation.ValueStringPointer(),
-		LimitsMemorySwap:        plan.LimitsMemorySwap.ValueStringPointer(),
-		LimitsMemorySwappiness: func() *int {
-			if plan.LimitsMemorySwappiness.IsUnknown() || plan.LimitsMemorySwappiness.IsNull() {
-				return nil
-			}
-			value := int(*plan.LimitsMemorySwappiness.ValueInt64Pointer())
-			return &value
-		}(),
-		PostgresConf:           base64EncodeAttr(plan.PostgresConf),
-		PostgresDb:             plan.PostgresDb.ValueStringPointer(),
-		PostgresHostAuthMethod: plan.PostgresHostAuthMethod.ValueStringPointer(),
-		PostgresInitdbArgs:     plan.PostgresInitdbArgs.ValueStringPointer(),
-		PostgresPassword:       plan.PostgresPassword.ValueStringPointer(),
-		PostgresUser:           plan.PostgresUser.ValueStringPointer(),
-		ProjectUuid:            plan.ProjectUuid.ValueString(),
-		PublicPort: func() *int {
-			if plan.PublicPort.IsUnknown() || plan.PublicPort.IsNull() {
-				return nil
-			}
-			value := int(*plan.PublicPort.ValueInt64Pointer())
-			return &value
-		}(),
-		ServerUuid: plan.ServerUuid.ValueString(),
+	target := databaseCreateTarget("standalone-postgresql", "postgresql database", plan.commonDatabaseModel)
+	uuid := createReconciled(ctx, r.client, &resp.Diagnostics, target, plan, func() (string, *http.Response, []byte, error) {
+		createResp, err := r.client.CreateDatabasePostgresqlWithResponse(ctx, api.CreateDatabasePostgresqlJSONRequestBody{
+			Description:     plan.Description.ValueStringPointer(),
+			Name:            plan.Name.ValueStringPointer(),
+			DestinationUuid: plan.DestinationUuid.ValueStringPointer(),
+			EnvironmentName: plan.EnvironmentName.ValueString(),
+			EnvironmentUuid: plan.EnvironmentUuid.ValueString(),
+			Image:           plan.Image.ValueStringPointer(),
+			InstantDeploy:   plan.InstantDeploy.ValueBoolPointer(),
+			IsPublic:        plan.IsPublic.ValueBoolPointer(),
+			LimitsCpuShares: func() *int {
+				if plan.LimitsCpuShares.IsUnknown() || plan.LimitsCpuShares.IsNull() {
+					return nil
+				}
+				value := int(*plan.LimitsCpuShares.ValueInt64Pointer())
+				return &value
+			}(),
+			LimitsCpus:              plan.LimitsCpus.ValueStringPointer(),
+			LimitsCpuset:            plan.LimitsCpuset.ValueStringPointer(),
+			LimitsMemory:            plan.LimitsMemory.ValueStringPointer(),
+			LimitsMemoryReservation: plan.LimitsMemoryReservation.ValueStringPointer(),
+			LimitsMemorySwap:        plan.LimitsMemorySwap.ValueStringPointer(),
+			LimitsMemorySwappiness: func() *int {
+				if plan.LimitsMemorySwappiness.IsUnknown() || plan.LimitsMemorySwappiness.IsNull() {
+					return nil
+				}
+				value := int(*plan.LimitsMemorySwappiness.ValueInt64Pointer())
+				return &value
+			}(),
+			PostgresConf:           base64EncodeAttr(plan.PostgresConf),
+			PostgresDb:             plan.PostgresDb.ValueStringPointer(),
+			PostgresHostAuthMethod: plan.PostgresHostAuthMethod.ValueStringPointer(),
+			PostgresInitdbArgs:     plan.PostgresInitdbArgs.ValueStringPointer(),
+			PostgresPassword:       plan.PostgresPassword.ValueStringPointer(),
+			PostgresUser:           plan.PostgresUser.ValueStringPointer(),
+			ProjectUuid:            plan.ProjectUuid.ValueString(),
+			PublicPort: func() *int {
+				if plan.PublicPort.IsUnknown() || plan.PublicPort.IsNull() {
+					return nil
+				}
+				value := int(*plan.PublicPort.ValueInt64Pointer())
+				return &value
+			}(),
+			ServerUuid: plan.ServerUuid.ValueString(),
+		})
+		if err != nil {
+			return "", nil, nil, err
+		}
+		if createResp.StatusCode() != http.StatusCreated || createResp.JSON201 == nil {
+			return "", createResp.HTTPResponse, createResp.Body, nil
+		}
+		return createResp.JSON201.Uuid, createResp.HTTPResponse, createResp.Body, nil
 	})
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating postgresql database",
-			err.Error(),
-		)
-		return
-	}
-
-	if createResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating postgresql database",
-			fmt.Sprintf("Received %s creating postgresql database. Details: %s", createResp.Status(), createResp.Body),
-		)
+	if uuid == "" {
 		return
 	}
 
-	data := r.ReadFromAPI(ctx, &resp.Diagnostics, createResp.JSON201.Uuid, plan)
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, uuid, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 func (r *postgresqlDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state postgresqlDatabaseResourceModel
@@ -171,7 +172,11 @@ func (r *postgresqlDatabaseResource) Read(ctx context.Context, req resource.Read
 	}
 
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, state.Uuid.ValueString(), state)
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *postgresqlDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -248,9 +253,7 @@ func (r *postgresqlDatabaseResource) Update(ctx context.Context, req resource.Up
 	}
 
 	if updateResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code updating postgresql database",
-			fmt.Sprintf("Received %s updating postgresql database: uuid=%s. Details: %s", updateResp.Status(), uuid, updateResp.Body))
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("updating postgresql database: uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, plan)
 		return
 	}
 
@@ -260,6 +263,7 @@ func (r *postgresqlDatabaseResource) Update(ctx context.Context, req resource.Up
 
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, uuid, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *postgresqlDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -287,30 +291,14 @@ func (r *postgresqlDatabaseResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	if deleteResp.JSON200 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting postgresql database",
-			fmt.Sprintf("Received %s deleting postgresql database: %s. Details: %s", deleteResp.Status(), state, deleteResp.Body))
+	if deleteResp.JSON200 == nil && deleteResp.StatusCode() != http.StatusNotFound {
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("deleting postgresql database: uuid=%s", state.Uuid.ValueString()), deleteResp.HTTPResponse, deleteResp.Body, nil)
 		return
 	}
 }
 
 func (r *postgresqlDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ids := strings.Split(req.ID, "/")
-	if len(ids) != 4 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			"Import ID should be in the format: <server_uuid>/<project_uuid>/<environment_name>/<database_uuid>",
-		)
-		return
-	}
-
-	serverUuid, projectUuid, environmentName, uuid := ids[0], ids[1], ids[2], ids[3]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_uuid"), serverUuid)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUuid)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_name"), environmentName)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), uuid)...)
+	importDatabaseState(ctx, req, resp)
 }
 
 func (r *postgresqlDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -350,9 +338,7 @@ func (r *postgresqlDatabaseResource) ReadFromAPI(
 	}
 
 	if readResp.StatusCode() != http.StatusOK {
-		diags.AddError(
-			"Unexpected HTTP status code reading postgresql database",
-			fmt.Sprintf("Received %s for postgresql database: uuid=%s. Details: %s", readResp.Status(), uuid, readResp.Body))
+		util.AddAPIError(diags, fmt.Sprintf("reading postgresql database: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
 		return postgresqlDatabaseResourceModel{}
 	}
 
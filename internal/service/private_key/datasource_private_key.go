@@ -2,18 +2,23 @@ package private_key
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 
 	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
 	"terraform-provider-coolify/internal/provider/util"
 )
 
 var _ datasource.DataSource = &privateKeyDataSource{}
 var _ datasource.DataSourceWithConfigure = &privateKeyDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &privateKeyDataSource{}
 
 func NewPrivateKeyDataSource() datasource.DataSource {
 	return &privateKeyDataSource{}
@@ -29,13 +34,15 @@ func (d *privateKeyDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 func (d *privateKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Get a single Coolify private key by UUID.",
+		Description: "Get a single Coolify private key by exactly one of `uuid`, `name` or `fingerprint`.",
 		Attributes: map[string]schema.Attribute{
 			"description": schema.StringAttribute{
 				Computed: true,
 			},
 			"fingerprint": schema.StringAttribute{
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Private Key fingerprint, ie `SHA256:...`.",
 			},
 			"id": schema.Int64Attribute{
 				Computed: true,
@@ -44,7 +51,9 @@ func (d *privateKeyDataSource) Schema(ctx context.Context, req datasource.Schema
 				Computed: true,
 			},
 			"name": schema.StringAttribute{
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Private Key name",
 			},
 			"private_key": schema.StringAttribute{
 				Computed:  true,
@@ -54,7 +63,8 @@ func (d *privateKeyDataSource) Schema(ctx context.Context, req datasource.Schema
 				Computed: true,
 			},
 			"uuid": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				Description:         "Private Key UUID",
 				MarkdownDescription: "Private Key UUID",
 			},
@@ -68,6 +78,16 @@ func (d *privateKeyDataSource) Schema(ctx context.Context, req datasource.Schema
 	}
 }
 
+func (d *privateKeyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uuid"),
+			path.MatchRoot("name"),
+			path.MatchRoot("fingerprint"),
+		),
+	}
+}
+
 func (d *privateKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	util.ProviderDataFromDataSourceConfigureRequest(req, &d.client, resp)
 }
@@ -80,7 +100,15 @@ func (d *privateKeyDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	privateKey, err := d.client.GetPrivateKeyByUuidWithResponse(ctx, plan.Uuid.ValueString())
+	uuid := plan.Uuid.ValueString()
+	if plan.Uuid.IsNull() {
+		uuid = d.lookupUuid(ctx, &resp.Diagnostics, plan)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	privateKey, err := d.client.GetPrivateKeyByUuidWithResponse(ctx, uuid)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading private key", err.Error(),
@@ -89,13 +117,43 @@ func (d *privateKeyDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	if privateKey.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading private key",
-			fmt.Sprintf("Received %s for private key. Details: %s", privateKey.Status(), privateKey.Body),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading private key", privateKey.HTTPResponse, privateKey.Body, nil)
 		return
 	}
 
 	state := privateKeyModel{}.FromAPI(privateKey.JSON200)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// lookupUuid finds the UUID of the only private key matching the name or fingerprint.
+func (d *privateKeyDataSource) lookupUuid(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	plan privateKeyDataSourceModel,
+) string {
+	listResp, err := d.client.ListPrivateKeysWithResponse(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading private keys", err.Error(),
+		)
+		return ""
+	}
+
+	if listResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, "reading private keys", listResp.HTTPResponse, listResp.Body, nil)
+		return ""
+	}
+
+	lookup := filter.FromLookup(map[string]attr.Value{
+		"name":        plan.Name,
+		"fingerprint": plan.Fingerprint,
+	})
+	matcher := filter.Compile(ctx, lookup)
+	privateKey, ok := filter.SelectOne(diags, "private key", lookup, *listResp.JSON200, func(item api.PrivateKey) bool {
+		return matcher.OnStruct(privateKeyModel{}.FromAPI(&item))
+	})
+	if !ok {
+		return ""
+	}
+	return privateKeyModel{}.FromAPI(&privateKey).Uuid.ValueString()
+}
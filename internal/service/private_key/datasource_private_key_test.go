@@ -2,6 +2,7 @@ package private_key_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -25,6 +26,34 @@ func TestAccPrivatekeyDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "uuid", acctest.PrivateKeyUUID),
 				),
 			},
+			{ // Lookup by name
+				Config: fmt.Sprintf(`
+					data "coolify_private_key" "%[1]s" {
+						name = "localhost's key"
+					}
+				`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "uuid", acctest.PrivateKeyUUID),
+					resource.TestCheckResourceAttrSet(resName, "fingerprint"),
+				),
+			},
+			{ // Lookup without a match
+				Config: fmt.Sprintf(`
+					data "coolify_private_key" "%[1]s" {
+						name = "%[1]s"
+					}
+				`, randomName),
+				ExpectError: regexp.MustCompile(`No matching private key found`),
+			},
+			{ // More than one lookup attribute
+				Config: fmt.Sprintf(`
+					data "coolify_private_key" "%[1]s" {
+						uuid = "%[2]s"
+						name = "localhost's key"
+					}
+				`, randomName, acctest.PrivateKeyUUID),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
 		},
 	})
 }
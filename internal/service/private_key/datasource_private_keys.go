@@ -2,7 +2,6 @@ package private_key
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -36,17 +35,21 @@ func (d *privateKeysDataSource) Schema(ctx context.Context, req datasource.Schem
 
 	ds.Schema(ctx, datasource.SchemaRequest{}, &dsResp)
 
-	if attr, ok := dsResp.Schema.Attributes["uuid"].(schema.StringAttribute); ok {
-		attr.Required = false
-		attr.Computed = true
-		dsResp.Schema.Attributes["uuid"] = attr
+	// Lookup attributes of the single data source are only read here
+	for _, name := range []string{"uuid", "name", "fingerprint"} {
+		if attr, ok := dsResp.Schema.Attributes[name].(schema.StringAttribute); ok {
+			attr.Required = false
+			attr.Optional = false
+			attr.Computed = true
+			dsResp.Schema.Attributes[name] = attr
+		}
 	}
 
 	resp.Schema = schema.Schema{
 		Description:         "Get a list of Coolify private keys.",
 		MarkdownDescription: "Get a list of Coolify private keys.",
 		Attributes: map[string]schema.Attribute{
-			"private_keys": schema.SetNestedAttribute{
+			"private_keys": schema.ListNestedAttribute{
 				Description: "List of private keys",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
@@ -54,9 +57,14 @@ func (d *privateKeysDataSource) Schema(ctx context.Context, req datasource.Schem
 				},
 			},
 		},
-		Blocks: map[string]schema.Block{
-			"filter": filter.CreateDatasourceFilter(privateKeysFilterNames),
-		},
+	}
+
+	filterNames := filter.NamesFromSchema(dsResp.Schema.Attributes)
+	resp.Schema.Blocks = map[string]schema.Block{
+		"filter": filter.CreateDatasourceFilter(filterNames),
+	}
+	for name, attr := range filter.CreateDatasourceSort(filterNames, privateKeysMostRecentField) {
+		resp.Schema.Attributes[name] = attr
 	}
 }
 
@@ -83,14 +91,11 @@ func (d *privateKeysDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	if listResponse.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading private key",
-			fmt.Sprintf("Received %s for private keys. Details: %s", listResponse.Status(), listResponse.Body),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading private key", listResponse.HTTPResponse, listResponse.Body, nil)
 		return
 	}
 
-	state, diag := d.apiToModel(ctx, listResponse.JSON200, plan.Filter)
+	state, diag := d.apiToModel(ctx, listResponse.JSON200, plan.Filter, plan.SortModel)
 	resp.Diagnostics.Append(diag...)
 
 	if resp.Diagnostics.HasError() {
@@ -104,22 +109,27 @@ func (d *privateKeysDataSource) apiToModel(
 	ctx context.Context,
 	privateKeys *[]api.PrivateKey,
 	filters []filter.BlockModel,
+	sort filter.SortModel,
 ) (privateKeysDataSourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var privateKeyValues []privateKeyDataSourceModel
 
+	matcher := filter.Compile(ctx, filters)
 	for _, pk := range *privateKeys {
 		model := privateKeyDataSourceModel{}.FromAPI(&pk)
 
-		if !filter.OnStruct(ctx, model, filters) {
+		if !matcher.OnStruct(model) {
 			continue
 		}
 
 		privateKeyValues = append(privateKeyValues, model)
 	}
 
+	privateKeyValues = filter.Sort(privateKeyValues, privateKeyDataSourceModel.FilterAttributes, sort, privateKeysMostRecentField)
+
 	return privateKeysDataSourceModel{
 		PrivateKeys: privateKeyValues,
 		Filter:      filters,
+		SortModel:   sort,
 	}, diags
 }
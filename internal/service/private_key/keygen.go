@@ -0,0 +1,52 @@
+package private_key
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	algorithmED25519 = "ED25519"
+	algorithmRSA     = "RSA"
+
+	defaultRsaBits = 4096
+)
+
+// generatePrivateKey returns a new private key in OpenSSH format.
+func generatePrivateKey(algorithm string, rsaBits int) (string, error) {
+	var key interface{}
+	var err error
+
+	switch algorithm {
+	case algorithmED25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	case algorithmRSA:
+		key, err = rsa.GenerateKey(rand.Reader, rsaBits)
+	default:
+		return "", fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(block)), nil
+}
+
+// publicKeyFromPrivateKey returns the public key of a private key in OpenSSH authorized_keys format.
+func publicKeyFromPrivateKey(privateKey string) (string, error) {
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))), nil
+}
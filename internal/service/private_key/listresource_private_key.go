@@ -0,0 +1,77 @@
+package private_key
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ list.ListResource              = &privateKeyResource{}
+	_ list.ListResourceWithConfigure = &privateKeyResource{}
+)
+
+func NewPrivateKeyListResource() list.ListResource {
+	return &privateKeyResource{}
+}
+
+func (r *privateKeyResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	dsResp := datasource.SchemaResponse{}
+	NewPrivateKeysDataSource().Schema(ctx, datasource.SchemaRequest{}, &dsResp)
+	filterNames := filter.NamesFromSchema(dsResp.Schema.Attributes["private_keys"].(schema.ListNestedAttribute).NestedObject.Attributes)
+
+	resp.Schema = filter.CreateListResourceSchema(&resp.Diagnostics,
+		"List Coolify private keys to import, with the same filters as the `coolify_private_keys` data source.",
+		filterNames, privateKeysMostRecentField)
+}
+
+func (r *privateKeyResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config filter.ListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listResponse, err := r.client.ListPrivateKeysWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading private keys", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if listResponse.StatusCode() != http.StatusOK || listResponse.JSON200 == nil {
+		util.AddAPIError(&diags, "reading private keys", listResponse.HTTPResponse, listResponse.Body, nil)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var privateKeys []privateKeyDataSourceModel
+	matcher := filter.Compile(ctx, config.Filter)
+	for _, pk := range *listResponse.JSON200 {
+		model := privateKeyDataSourceModel{}.FromAPI(&pk)
+		if matcher.OnStruct(model) {
+			privateKeys = append(privateKeys, model)
+		}
+	}
+	privateKeys = filter.Sort(privateKeys, privateKeyDataSourceModel.FilterAttributes, config.SortModel, privateKeysMostRecentField)
+
+	stream.Results = util.ListResults(ctx, req, privateKeys, func(pk privateKeyDataSourceModel, result *list.ListResult) {
+		result.DisplayName = pk.Name.ValueString()
+		result.Diagnostics.Append(result.Identity.Set(ctx, privateKeyIdentityModel{Uuid: pk.Uuid})...)
+
+		if req.IncludeResource {
+			data := r.readFromAPI(ctx, &result.Diagnostics, pk.Uuid.ValueString())
+			r.copyMissingAttributes(&privateKeyResourceModel{RotationTriggers: types.MapNull(types.StringType)}, &data)
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
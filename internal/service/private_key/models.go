@@ -22,11 +22,24 @@ type privateKeyModel struct {
 	UpdatedAt    types.String `tfsdk:"updated_at"`
 }
 
-type privateKeyResourceModel = privateKeyModel
+type privateKeyResourceModel struct {
+	privateKeyModel
+	Algorithm types.String `tfsdk:"algorithm"`
+	RsaBits   types.Int64  `tfsdk:"rsa_bits"`
+	PublicKey types.String `tfsdk:"public_key"`
+
+	RotationTriggers types.Map `tfsdk:"rotation_triggers"`
+}
 type privateKeyDataSourceModel = privateKeyModel
+
+type privateKeyIdentityModel struct {
+	Uuid types.String `tfsdk:"uuid"`
+}
+
 type privateKeysDataSourceModel struct {
 	PrivateKeys []privateKeyDataSourceModel `tfsdk:"private_keys"`
 	Filter      []filter.BlockModel         `tfsdk:"filter"`
+	filter.SortModel
 }
 
 func (m privateKeyModel) FromAPI(apiModel *api.PrivateKey) privateKeyModel {
@@ -44,13 +57,8 @@ func (m privateKeyModel) FromAPI(apiModel *api.PrivateKey) privateKeyModel {
 	}
 }
 
-var privateKeysFilterNames = []string{"name", "description", "team_id", "is_git_related"}
+var privateKeysMostRecentField = "created_at"
 
 func (m privateKeyModel) FilterAttributes() map[string]attr.Value {
-	return map[string]attr.Value{
-		"description":    m.Description,
-		"is_git_related": m.IsGitRelated,
-		"name":           m.Name,
-		"team_id":        m.TeamId,
-	}
+	return filter.StructAttributes(m)
 }
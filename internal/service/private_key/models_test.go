@@ -1,10 +1,15 @@
 package private_key
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/stretchr/testify/assert"
 
+	"terraform-provider-coolify/internal/filter"
+
 	"terraform-provider-coolify/internal/testutils"
 )
 
@@ -14,7 +19,13 @@ func TestPrivateKeyModel_Attributes(t *testing.T) {
 	expected := testutils.GenerateAttrTypesFromStruct(t, model)
 	actual := model.FilterAttributes()
 
-	for _, key := range privateKeysFilterNames {
+	// Every filter name derived from the list schema must be filterable
+	resp := &datasource.SchemaResponse{}
+	NewPrivateKeysDataSource().Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	filterNames := filter.NamesFromSchema(resp.Schema.Attributes["private_keys"].(schema.ListNestedAttribute).NestedObject.Attributes)
+	assert.NotEmpty(t, filterNames)
+
+	for _, key := range filterNames {
 		_, exists := actual[key]
 		assert.True(t, exists, "Key %q should exist in actual attributes", key)
 	}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -23,10 +25,12 @@ import (
 )
 
 var (
-	_ resource.Resource                = &privateKeyResource{}
-	_ resource.ResourceWithConfigure   = &privateKeyResource{}
-	_ resource.ResourceWithImportState = &privateKeyResource{}
-	_ resource.ResourceWithModifyPlan  = &privateKeyResource{}
+	_ resource.Resource                   = &privateKeyResource{}
+	_ resource.ResourceWithConfigure      = &privateKeyResource{}
+	_ resource.ResourceWithImportState    = &privateKeyResource{}
+	_ resource.ResourceWithModifyPlan     = &privateKeyResource{}
+	_ resource.ResourceWithValidateConfig = &privateKeyResource{}
+	_ resource.ResourceWithIdentity       = &privateKeyResource{}
 )
 
 func NewPrivateKeyResource() resource.Resource {
@@ -39,11 +43,21 @@ type privateKeyResource struct {
 
 func (r *privateKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_private_key"
+	// Rotating a key replaces it by a new key with another UUID
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *privateKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Create, read, update, and delete a Coolify private key resource.",
+		Description: "Create, read, update, and delete a Coolify private key resource." +
+			"\nWhen `private_key` is omitted, a new key is generated using `algorithm`." +
+			"\nChanging `rotation_triggers`, `algorithm` or `rsa_bits` rotates the key: a new key is created, every server using the old key is switched to it and validated," +
+			" and the old key is only deleted once a validation with the new key completed and every server is reachable and usable." +
+			" Should a server fail, the servers are switched back to the old key and the new key is deleted." +
+			" Rotating a key given in `private_key` requires a different key." +
+			"\n**NOTE:** The public key of the new key must already be in the `authorized_keys` of every server using the key, or every rotation rolls back." +
+			" A generated key is only known once it is created during the rotation, so rotating it requires servers that authorize new keys by other means;" +
+			" otherwise, authorize a key first and rotate to it by changing `private_key`.",
 		Attributes: map[string]schema.Attribute{
 			"description": schema.StringAttribute{
 				Optional:   true,
@@ -63,8 +77,35 @@ func (r *privateKeyResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 			"private_key": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "Private key in PEM or OpenSSH format. Generated when omitted.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"algorithm": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Algorithm of the generated key: `%s` or `%s`. Defaults to `%s` when `private_key` is omitted.",
+					algorithmED25519, algorithmRSA, algorithmED25519),
+				Validators: []validator.String{
+					stringvalidator.OneOf(algorithmED25519, algorithmRSA),
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key")),
+				},
+			},
+			"rsa_bits": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Size of the generated RSA key in bits. Only used when `algorithm` is `%s`. Defaults to `%d`.", algorithmRSA, defaultRsaBits),
+				Validators:  []validator.Int64{int64validator.AtLeast(2048)},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that rotate the key when added, changed or removed, ie a timestamp or a version number.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Public key in OpenSSH authorized_keys format, ie `ssh-ed25519 AAAA...`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"team_id": schema.Int64Attribute{
 				Computed:      true,
@@ -89,10 +130,38 @@ func (r *privateKeyResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (r *privateKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"uuid": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "UUID of the private key.",
+			},
+		},
+	}
+}
+
 func (r *privateKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
 }
 
+func (r *privateKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config privateKeyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RsaBits.IsNull() && !config.Algorithm.IsUnknown() && config.Algorithm.ValueString() != algorithmRSA {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rsa_bits"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("`rsa_bits` can only be set when `algorithm` is `%s`.", algorithmRSA),
+		)
+	}
+}
+
 func (r *privateKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan privateKeyResourceModel
 
@@ -101,6 +170,11 @@ func (r *privateKeyResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	r.generateMissingPrivateKey(ctx, &resp.Diagnostics, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Creating private key", map[string]interface{}{
 		"name": plan.Name.ValueString(),
 	})
@@ -119,15 +193,14 @@ func (r *privateKeyResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	if createResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating private key",
-			fmt.Sprintf("Received %s creating private key. Details: %s", createResp.Status(), createResp.Body),
-		)
+		util.AddAPIError(&resp.Diagnostics, "creating private key", createResp.HTTPResponse, createResp.Body, plan)
 		return
 	}
 
 	data := r.readFromAPI(ctx, &resp.Diagnostics, *createResp.JSON201.Uuid)
+	r.copyMissingAttributes(&plan, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, privateKeyIdentityModel{Uuid: data.Uuid})...)
 }
 
 func (r *privateKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -147,7 +220,12 @@ func (r *privateKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	data := r.readFromAPI(ctx, &resp.Diagnostics, state.Uuid.ValueString())
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
+	r.copyMissingAttributes(&state, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, privateKeyIdentityModel{Uuid: data.Uuid})...)
 }
 
 func (r *privateKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -170,6 +248,30 @@ func (r *privateKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if needsRotation(plan, state) {
+		r.generateMissingPrivateKey(ctx, &resp.Diagnostics, &plan)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		newUuid := r.rotate(ctx, &resp.Diagnostics, plan, state)
+		if newUuid == "" {
+			return
+		}
+
+		// Keep track of the new key even if the old one could not be deleted
+		var readDiags diag.Diagnostics
+		data := r.readFromAPI(ctx, &readDiags, newUuid)
+		resp.Diagnostics.Append(readDiags...)
+		if readDiags.HasError() {
+			return
+		}
+		r.copyMissingAttributes(&plan, &data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, privateKeyIdentityModel{Uuid: data.Uuid})...)
+		return
+	}
+
 	tflog.Debug(ctx, "Updating private key", map[string]interface{}{
 		"uuid": uuid,
 	})
@@ -188,14 +290,14 @@ func (r *privateKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	if updateResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code updating private key",
-			fmt.Sprintf("Received %s updating private key: uuid=%s. Details: %s", updateResp.Status(), uuid, updateResp.Body))
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("updating private key: uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, plan)
 		return
 	}
 
 	data := r.readFromAPI(ctx, &resp.Diagnostics, uuid)
+	r.copyMissingAttributes(&plan, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, privateKeyIdentityModel{Uuid: data.Uuid})...)
 }
 
 func (r *privateKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -217,16 +319,14 @@ func (r *privateKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	if deleteResp.JSON200 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting private key",
-			fmt.Sprintf("Received %s deleting private key: %s. Details: %s", deleteResp.Status(), state, deleteResp.Body))
+	if deleteResp.JSON200 == nil && deleteResp.StatusCode() != http.StatusNotFound {
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("deleting private key: uuid=%s", state.Uuid.ValueString()), deleteResp.HTTPResponse, deleteResp.Body, nil)
 		return
 	}
 }
 
 func (r *privateKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("uuid"), path.Root("uuid"), req, resp)
 }
 
 func (r *privateKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -239,9 +339,34 @@ func (r *privateKeyResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
-	// If the private key is being updated, the fingerprint will change
+	// If the private key is being updated, the fingerprint and public key will change
 	if !plan.PrivateKey.Equal(state.PrivateKey) {
 		plan.Fingerprint = types.StringUnknown()
+		plan.PublicKey = types.StringUnknown()
+	}
+
+	// A rotation creates a new key, generated again unless the configuration provides one
+	if needsRotation(*plan, *state) {
+		var configPrivateKey types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &configPrivateKey)...)
+		if configPrivateKey.IsNull() {
+			plan.PrivateKey = types.StringUnknown()
+		} else if configPrivateKey.Equal(state.PrivateKey) {
+			// Uploading the current key again would switch every server to a copy of the same key
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Private key can not be rotated",
+				"`rotation_triggers` changed, but `private_key` is set to the current key, so the rotated key would be the same key."+
+					" Change `private_key` together with `rotation_triggers`, or omit `private_key` to generate a new key.",
+			)
+			return
+		}
+
+		plan.Uuid = types.StringUnknown()
+		plan.Id = types.Int64Unknown()
+		plan.Fingerprint = types.StringUnknown()
+		plan.PublicKey = types.StringUnknown()
+		plan.CreatedAt = types.StringUnknown()
 	}
 
 	resp.Plan.Set(ctx, &plan)
@@ -262,11 +387,63 @@ func (r *privateKeyResource) readFromAPI(
 	}
 
 	if readResp.StatusCode() != http.StatusOK {
-		diags.AddError(
-			"Unexpected HTTP status code reading private key",
-			fmt.Sprintf("Received %s for private key: uuid=%s. Details: %s", readResp.Status(), uuid, readResp.Body))
+		util.AddAPIError(diags, fmt.Sprintf("reading private key: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
 		return privateKeyResourceModel{}
 	}
 
-	return privateKeyResourceModel{}.FromAPI(readResp.JSON200)
+	data := privateKeyResourceModel{
+		privateKeyModel: privateKeyModel{}.FromAPI(readResp.JSON200),
+		PublicKey:       types.StringNull(),
+	}
+
+	if publicKey, err := publicKeyFromPrivateKey(data.PrivateKey.ValueString()); err == nil {
+		data.PublicKey = types.StringValue(publicKey)
+	} else {
+		tflog.Warn(ctx, "Unable to derive public key from private key", map[string]interface{}{
+			"uuid":  uuid,
+			"error": err.Error(),
+		})
+	}
+
+	return data
+}
+
+func (r *privateKeyResource) copyMissingAttributes(
+	plan *privateKeyResourceModel,
+	data *privateKeyResourceModel,
+) {
+	// Values that are not returned in API response
+	data.Algorithm = plan.Algorithm
+	data.RsaBits = plan.RsaBits
+	data.RotationTriggers = plan.RotationTriggers
+}
+
+// generateMissingPrivateKey generates the key material when the plan does not provide it.
+func (r *privateKeyResource) generateMissingPrivateKey(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	plan *privateKeyResourceModel,
+) {
+	if !plan.PrivateKey.IsUnknown() && !plan.PrivateKey.IsNull() {
+		return
+	}
+
+	algorithm := algorithmED25519
+	if !plan.Algorithm.IsNull() {
+		algorithm = plan.Algorithm.ValueString()
+	}
+	rsaBits := defaultRsaBits
+	if !plan.RsaBits.IsNull() {
+		rsaBits = int(plan.RsaBits.ValueInt64())
+	}
+
+	tflog.Debug(ctx, "Generating private key", map[string]interface{}{
+		"algorithm": algorithm,
+	})
+	privateKey, err := generatePrivateKey(algorithm, rsaBits)
+	if err != nil {
+		diags.AddError("Error generating private key", err.Error())
+		return
+	}
+	plan.PrivateKey = types.StringValue(privateKey)
 }
@@ -2,6 +2,7 @@ package private_key_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -65,6 +66,101 @@ func TestAccPrivateKeyResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "private_key", privateKey2),
 				),
 			},
+			{ // Rotating to the same key is refused
+				Config: fmt.Sprintf(`
+					resource "coolify_private_key" "%[1]s" {
+						name              = "%[1]s"
+						description       = "Terraform acceptance testing"
+						private_key       = "%[2]s"
+						rotation_triggers = { version = "1" }
+					}
+				`, randomName, strings.ReplaceAll(privateKey2, "\n", "\\n")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Private key can not be rotated`),
+			},
+		},
+	})
+}
+
+func TestAccPrivateKeyResourceGenerated(t *testing.T) {
+	randomName := acctest.GetRandomResourceName("pk")
+	resName := "coolify_private_key." + randomName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{ // Generate an RSA key
+				Config: fmt.Sprintf(`
+					resource "coolify_private_key" "%[1]s" {
+						name      = "%[1]s"
+						algorithm = "RSA"
+						rsa_bits  = 2048
+					}
+				`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "private_key"),
+					resource.TestCheckResourceAttrSet(resName, "fingerprint"),
+					resource.TestMatchResourceAttr(resName, "public_key", regexp.MustCompile(`^ssh-rsa `)),
+				),
+			},
+			{ // Changing the algorithm rotates the key
+				Config: fmt.Sprintf(`
+					resource "coolify_private_key" "%[1]s" {
+						name      = "%[1]s"
+						algorithm = "ED25519"
+					}
+				`, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resName, tfjsonpath.New("uuid")),
+						plancheck.ExpectUnknownValue(resName, tfjsonpath.New("private_key")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resName, "public_key", regexp.MustCompile(`^ssh-ed25519 `)),
+				),
+			},
+			{ // Adding rotation triggers rotates the key
+				Config: fmt.Sprintf(`
+					resource "coolify_private_key" "%[1]s" {
+						name              = "%[1]s"
+						algorithm         = "ED25519"
+						rotation_triggers = { version = "2" }
+					}
+				`, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(resName, tfjsonpath.New("uuid")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "rotation_triggers.version", "2"),
+				),
+			},
+			{ // Changing the rotation triggers rotates the key
+				Config: fmt.Sprintf(`
+					resource "coolify_private_key" "%[1]s" {
+						name              = "%[1]s"
+						algorithm         = "ED25519"
+						rotation_triggers = { version = "3" }
+					}
+				`, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resName, tfjsonpath.New("uuid")),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "rotation_triggers.version", "3"),
+					resource.TestMatchResourceAttr(resName, "public_key", regexp.MustCompile(`^ssh-ed25519 `)),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,281 @@
+package private_key
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/flatten"
+	"terraform-provider-coolify/internal/provider/util"
+	"terraform-provider-coolify/internal/service"
+)
+
+var (
+	// rotationPollInterval is the time between readiness checks of a server switched to a rotated key.
+	rotationPollInterval = 5 * time.Second
+
+	// rotationReadyTimeout is the time in seconds a server switched to a rotated key has to become usable.
+	rotationReadyTimeout int64 = consts.DEFAULT_SERVER_READY_TIMEOUT
+)
+
+// rotatedServer is a server that was switched to a new private key.
+type rotatedServer struct {
+	Uuid string
+	Name string
+}
+
+// needsRotation reports whether the plan replaces the key with a new one, instead of updating it in place.
+// Any change of the triggers rotates the key, including adding them.
+// The algorithm and size are not known after import, so setting them afterwards does not rotate the key.
+func needsRotation(plan, state privateKeyResourceModel) bool {
+	return !plan.RotationTriggers.Equal(state.RotationTriggers) ||
+		(!state.Algorithm.IsNull() && !plan.Algorithm.Equal(state.Algorithm)) ||
+		(!state.RsaBits.IsNull() && !plan.RsaBits.Equal(state.RsaBits))
+}
+
+// serverPrivateKeyIdFromBody extracts the private key ID from a raw server response.
+// It is not part of the documented API model.
+func serverPrivateKeyIdFromBody(body []byte) (int64, bool) {
+	var server struct {
+		PrivateKeyId *json.Number `json:"private_key_id"`
+	}
+	if err := json.Unmarshal(body, &server); err != nil || server.PrivateKeyId == nil {
+		return 0, false
+	}
+
+	id, err := server.PrivateKeyId.Int64()
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+func formatRotatedServers(servers []rotatedServer) string {
+	if len(servers) == 0 {
+		return "  (none)"
+	}
+
+	lines := make([]string, 0, len(servers))
+	for _, server := range servers {
+		lines = append(lines, fmt.Sprintf("  - %s (uuid=%s)", server.Name, server.Uuid))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// serversUsingPrivateKey returns the servers that connect with the private key.
+func (r *privateKeyResource) serversUsingPrivateKey(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	privateKeyId int64,
+) []rotatedServer {
+	listResp, err := r.client.ListServersWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading servers", err.Error())
+		return nil
+	}
+
+	if listResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, "reading servers", listResp.HTTPResponse, listResp.Body, nil)
+		return nil
+	}
+
+	var servers []rotatedServer
+	for _, server := range *listResp.JSON200 {
+		uuid := flatten.String(server.Uuid).ValueString()
+
+		readResp, err := r.client.GetServerByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error reading server: uuid=%s", uuid),
+				err.Error(),
+			)
+			return nil
+		}
+
+		if readResp.StatusCode() != http.StatusOK {
+			util.AddAPIError(diags, fmt.Sprintf("reading server: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
+			return nil
+		}
+
+		if id, ok := serverPrivateKeyIdFromBody(readResp.Body); ok && id == privateKeyId {
+			servers = append(servers, rotatedServer{Uuid: uuid, Name: flatten.String(server.Name).ValueString()})
+		}
+	}
+	return servers
+}
+
+// rotate creates a new key, switches every server using the old key to it and waits until each one is usable,
+// then deletes the old key. Should any server fail, the servers are switched back to the old key and the new key is deleted.
+// It returns the UUID of the new key, or an empty string if the rotation failed.
+func (r *privateKeyResource) rotate(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	plan privateKeyResourceModel,
+	state privateKeyResourceModel,
+) string {
+	oldUuid := state.Uuid.ValueString()
+
+	servers := r.serversUsingPrivateKey(ctx, diags, state.Id.ValueInt64())
+	if diags.HasError() {
+		return ""
+	}
+
+	tflog.Debug(ctx, "Rotating private key", map[string]interface{}{
+		"uuid":    oldUuid,
+		"servers": len(servers),
+	})
+	createResp, err := r.client.CreatePrivateKeyWithResponse(ctx, api.CreatePrivateKeyJSONRequestBody{
+		Description: plan.Description.ValueStringPointer(),
+		Name:        plan.Name.ValueStringPointer(),
+		PrivateKey:  plan.PrivateKey.ValueString(),
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Error creating private key",
+			err.Error(),
+		)
+		return ""
+	}
+
+	if createResp.StatusCode() != http.StatusCreated {
+		util.AddAPIError(diags, "creating private key", createResp.HTTPResponse, createResp.Body, plan)
+		return ""
+	}
+
+	if createResp.JSON201 == nil || createResp.JSON201.Uuid == nil {
+		diags.AddError(
+			"Error creating private key",
+			fmt.Sprintf("Unable to decode the private key created by Coolify, it may have to be deleted manually. Body: %s", createResp.Body),
+		)
+		return ""
+	}
+
+	newUuid := *createResp.JSON201.Uuid
+	var moved []rotatedServer
+	for _, server := range servers {
+		tflog.Debug(ctx, "Switching server to rotated private key", map[string]interface{}{
+			"uuid":             server.Uuid,
+			"private_key_uuid": newUuid,
+		})
+		if err := r.switchServerPrivateKey(ctx, server.Uuid, newUuid); err != nil {
+			r.rollback(ctx, diags, oldUuid, newUuid, moved,
+				fmt.Sprintf("Unable to switch server %s (uuid=%s) to the new private key: %s", server.Name, server.Uuid, err))
+			return ""
+		}
+		moved = append(moved, server)
+
+		// Validation runs asynchronously, the server is only known to work with the new key once a validation
+		// triggered after the switch completed and it is usable
+		var serverDiags diag.Diagnostics
+		if validation, ok := service.ValidateServer(ctx, r.client, &serverDiags, server.Uuid); ok {
+			service.WaitForServerReady(ctx, r.client, &serverDiags, server.Uuid, validation, rotationReadyTimeout, rotationPollInterval, func(message string) {
+				tflog.Debug(ctx, message)
+			})
+		}
+		if serverDiags.HasError() {
+			diags.Append(serverDiags...)
+			r.rollback(ctx, diags, oldUuid, newUuid, moved,
+				fmt.Sprintf("Server %s (uuid=%s) did not become usable with the new private key.", server.Name, server.Uuid))
+			return ""
+		}
+	}
+
+	tflog.Debug(ctx, "Deleting rotated private key", map[string]interface{}{
+		"uuid": oldUuid,
+	})
+	deleteResp, err := r.client.DeletePrivateKeyByUuidWithResponse(ctx, oldUuid)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to delete rotated private key %s, got error: %s", oldUuid, err))
+		return newUuid
+	}
+
+	if deleteResp.JSON200 == nil && deleteResp.StatusCode() != http.StatusNotFound {
+		util.AddAPIError(diags, fmt.Sprintf("deleting rotated private key: uuid=%s", oldUuid), deleteResp.HTTPResponse, deleteResp.Body, nil)
+		return newUuid
+	}
+
+	diags.AddWarning(
+		"Private key rotated",
+		fmt.Sprintf("Private key %s was replaced by %s. The following servers were switched to the new key and validated:\n%s",
+			oldUuid, newUuid, formatRotatedServers(moved)),
+	)
+	return newUuid
+}
+
+// rollback switches the servers back to the old key and deletes the new key, so a failed rotation leaves nothing behind.
+func (r *privateKeyResource) rollback(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	oldUuid string,
+	newUuid string,
+	moved []rotatedServer,
+	reason string,
+) {
+	// Roll back even when the rotation failed because its context was cancelled
+	ctx = context.WithoutCancel(ctx)
+
+	var restored []rotatedServer
+	var failures []string
+	for _, server := range moved {
+		tflog.Debug(ctx, "Switching server back to previous private key", map[string]interface{}{
+			"uuid":             server.Uuid,
+			"private_key_uuid": oldUuid,
+		})
+		if err := r.switchServerPrivateKey(ctx, server.Uuid, oldUuid); err != nil {
+			failures = append(failures, fmt.Sprintf("  - server %s (uuid=%s) still uses the new key: %s", server.Name, server.Uuid, err))
+			continue
+		}
+		restored = append(restored, server)
+
+		var validateDiags diag.Diagnostics
+		if _, ok := service.ValidateServer(ctx, r.client, &validateDiags, server.Uuid); !ok {
+			failures = append(failures, fmt.Sprintf("  - server %s (uuid=%s) could not be validated again, validate it in Coolify", server.Name, server.Uuid))
+		}
+	}
+
+	if len(failures) == 0 {
+		tflog.Debug(ctx, "Deleting new private key of failed rotation", map[string]interface{}{
+			"uuid": newUuid,
+		})
+		deleteResp, err := r.client.DeletePrivateKeyByUuidWithResponse(ctx, newUuid)
+		if err == nil && deleteResp.JSON200 == nil && deleteResp.StatusCode() != http.StatusNotFound {
+			err = api.DecodeError(deleteResp.HTTPResponse, deleteResp.Body)
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("  - the new private key %s could not be deleted: %s", newUuid, err))
+		}
+	} else {
+		// Servers still using the new key would lose access if it was deleted
+		failures = append(failures, fmt.Sprintf("  - the new private key %s was kept, as servers still use it", newUuid))
+	}
+
+	detail := fmt.Sprintf("%s\nThe rotation was rolled back: the old private key %s was kept, and these servers were switched back to it:\n%s",
+		reason, oldUuid, formatRotatedServers(restored))
+	if len(failures) > 0 {
+		detail += "\nThe rollback did not complete, fix the following in Coolify:\n" + strings.Join(failures, "\n")
+	}
+	diags.AddError("Private key rotation failed", detail)
+}
+
+// switchServerPrivateKey points the server at another private key.
+func (r *privateKeyResource) switchServerPrivateKey(ctx context.Context, serverUuid, privateKeyUuid string) error {
+	updateResp, err := r.client.UpdateServerByUuidWithResponse(ctx, serverUuid, api.UpdateServerByUuidJSONRequestBody{
+		PrivateKeyUuid: &privateKeyUuid,
+	})
+	if err != nil {
+		return err
+	}
+	if updateResp.StatusCode() != http.StatusCreated {
+		return api.DecodeError(updateResp.HTTPResponse, updateResp.Body)
+	}
+	return nil
+}
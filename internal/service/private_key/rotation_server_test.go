@@ -0,0 +1,166 @@
+package private_key
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+// mockCoolify serves the endpoints used by a rotation, and records the requests that change something.
+// Server "web" uses private key 1 ("old"), server "other" uses private key 2.
+type mockCoolify struct {
+	mu       sync.Mutex
+	requests []string
+
+	// usable reports whether a server is usable with the private key it currently uses.
+	usable func(privateKeyUuid string) bool
+	// switchStatus is the status returned when switching a server to the new key.
+	switchStatus int
+	// validationStalled leaves the settings of server "web" untouched by validations, as if none completed.
+	validationStalled bool
+
+	privateKeyUuid string
+	// validations counts the validations of server "web", each one updates its settings.
+	validations int
+}
+
+func (m *mockCoolify) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/servers":
+			fmt.Fprint(w, `[{"uuid": "web", "name": "web"}, {"uuid": "other", "name": "other"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/servers/other":
+			fmt.Fprint(w, `{"uuid": "other", "private_key_id": 2, "settings": {"is_reachable": true, "is_usable": true}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/servers/web":
+			usable := m.usable(m.privateKeyUuid)
+			fmt.Fprintf(w, `{"uuid": "web", "private_key_id": 1, "settings": {"updated_at": "%d", "is_reachable": %t, "is_usable": %t}}`, m.validations, usable, usable)
+		case r.Method == http.MethodPost && r.URL.Path == "/security/keys":
+			m.requests = append(m.requests, "create key")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"uuid": "new"}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/servers/web":
+			var body struct {
+				PrivateKeyUuid string `json:"private_key_uuid"`
+			}
+			data, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(data, &body))
+			m.requests = append(m.requests, "switch web to "+body.PrivateKeyUuid)
+			if body.PrivateKeyUuid == "new" && m.switchStatus != http.StatusCreated {
+				w.WriteHeader(m.switchStatus)
+				fmt.Fprint(w, `{"message": "Validation failed.", "errors": {"private_key_uuid": ["The private key is invalid."]}}`)
+				return
+			}
+			m.privateKeyUuid = body.PrivateKeyUuid
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"uuid": "web"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/servers/web/validate":
+			m.requests = append(m.requests, "validate web")
+			if !m.validationStalled {
+				m.validations++
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"message": "Validation started."}`)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/security/keys/"):
+			m.requests = append(m.requests, "delete key "+strings.TrimPrefix(r.URL.Path, "/security/keys/"))
+			fmt.Fprint(w, `{"message": "Private Key deleted."}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	defer func(interval time.Duration, timeout int64) {
+		rotationPollInterval, rotationReadyTimeout = interval, timeout
+	}(rotationPollInterval, rotationReadyTimeout)
+	rotationPollInterval = 10 * time.Millisecond
+	rotationReadyTimeout = 1
+
+	state := privateKeyResourceModel{privateKeyModel: privateKeyModel{Id: types.Int64Value(1), Uuid: types.StringValue("old")}}
+	plan := privateKeyResourceModel{privateKeyModel: privateKeyModel{Name: types.StringValue("key"), PrivateKey: types.StringValue("new key")}}
+
+	tests := []struct {
+		name              string
+		usable            func(privateKeyUuid string) bool
+		switchStatus      int
+		validationStalled bool
+		expectedUuid      string
+		expected          []string
+	}{
+		{
+			name:         "servers work with the new key",
+			usable:       func(string) bool { return true },
+			switchStatus: http.StatusCreated,
+			expectedUuid: "new",
+			expected:     []string{"create key", "switch web to new", "validate web", "delete key old"},
+		},
+		{
+			name:         "server not usable with the new key",
+			usable:       func(privateKeyUuid string) bool { return privateKeyUuid != "new" },
+			switchStatus: http.StatusCreated,
+			expected:     []string{"create key", "switch web to new", "validate web", "switch web to old", "validate web", "delete key new"},
+		},
+		{
+			name:              "validation with the new key does not complete",
+			usable:            func(string) bool { return true },
+			switchStatus:      http.StatusCreated,
+			validationStalled: true,
+			expected:          []string{"create key", "switch web to new", "validate web", "switch web to old", "validate web", "delete key new"},
+		},
+		{
+			name:         "server can not be switched",
+			usable:       func(string) bool { return true },
+			switchStatus: http.StatusUnprocessableEntity,
+			expected:     []string{"create key", "switch web to new", "delete key new"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockCoolify{usable: tt.usable, switchStatus: tt.switchStatus, validationStalled: tt.validationStalled, privateKeyUuid: "old"}
+			server := httptest.NewServer(mock.handler(t))
+			defer server.Close()
+
+			client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+			require.NoError(t, err)
+			r := &privateKeyResource{client: client}
+
+			var diags diag.Diagnostics
+			uuid := r.rotate(context.Background(), &diags, plan, state)
+
+			assert.Equal(t, tt.expectedUuid, uuid)
+			assert.Equal(t, tt.expected, mock.requests)
+			if tt.expectedUuid != "" {
+				assert.False(t, diags.HasError(), diags)
+				require.Equal(t, 1, diags.WarningsCount())
+				assert.Contains(t, diags.Warnings()[0].Detail(), "web (uuid=web)")
+				return
+			}
+
+			require.True(t, diags.HasError())
+			rollback := diags.Errors()[len(diags.Errors())-1]
+			assert.Equal(t, "Private key rotation failed", rollback.Summary())
+			assert.Contains(t, rollback.Detail(), "the old private key old was kept")
+			assert.NotContains(t, rollback.Detail(), "The rollback did not complete")
+		})
+	}
+}
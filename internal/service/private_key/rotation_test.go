@@ -0,0 +1,65 @@
+package private_key
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerPrivateKeyIdFromBody(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		expectedId int64
+		expectedOk bool
+	}{
+		{"number", `{"uuid":"abc","private_key_id":42}`, 42, true},
+		{"missing", `{"uuid":"abc"}`, 0, false},
+		{"null", `{"private_key_id":null}`, 0, false},
+		{"invalid", `not json`, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := serverPrivateKeyIdFromBody([]byte(tt.body))
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedId, id)
+		})
+	}
+}
+
+func TestNeedsRotation(t *testing.T) {
+	triggers := func(version string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(version)})
+	}
+	model := func(algorithm types.String, rsaBits types.Int64, rotationTriggers types.Map) privateKeyResourceModel {
+		return privateKeyResourceModel{Algorithm: algorithm, RsaBits: rsaBits, RotationTriggers: rotationTriggers}
+	}
+	ed25519 := types.StringValue(algorithmED25519)
+	rsa := types.StringValue(algorithmRSA)
+	noBits := types.Int64Null()
+	noTriggers := types.MapNull(types.StringType)
+
+	tests := []struct {
+		name     string
+		plan     privateKeyResourceModel
+		state    privateKeyResourceModel
+		expected bool
+	}{
+		{"unchanged", model(ed25519, noBits, triggers("1")), model(ed25519, noBits, triggers("1")), false},
+		{"triggers changed", model(ed25519, noBits, triggers("2")), model(ed25519, noBits, triggers("1")), true},
+		{"triggers removed", model(ed25519, noBits, noTriggers), model(ed25519, noBits, triggers("1")), true},
+		{"triggers added", model(ed25519, noBits, triggers("1")), model(ed25519, noBits, noTriggers), true},
+		{"algorithm changed", model(rsa, noBits, noTriggers), model(ed25519, noBits, noTriggers), true},
+		{"algorithm after import", model(ed25519, noBits, noTriggers), model(types.StringNull(), noBits, noTriggers), false},
+		{"rsa bits changed", model(rsa, types.Int64Value(4096), noTriggers), model(rsa, types.Int64Value(2048), noTriggers), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, needsRotation(tt.plan, tt.state))
+		})
+	}
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -59,10 +58,7 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	if response.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading project",
-			fmt.Sprintf("Received %s for project. Details: %s", response.Status(), string(response.Body)),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading project", response.HTTPResponse, response.Body, nil)
 		return
 	}
 
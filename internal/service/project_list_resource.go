@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ list.ListResource              = &projectResource{}
+	_ list.ListResourceWithConfigure = &projectResource{}
+)
+
+func NewProjectListResource() list.ListResource {
+	return &projectResource{}
+}
+
+func (r *projectResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceSchema(ctx, &resp.Diagnostics,
+		"List Coolify projects to import, with the same filters as the `coolify_projects` data source.",
+		NewProjectsDataSource(), "projects", projectsMostRecentField)
+}
+
+func (r *projectResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config filter.ListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listResponse, err := r.client.ListProjectsWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading projects", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if listResponse.StatusCode() != http.StatusOK || listResponse.JSON200 == nil {
+		util.AddAPIError(&diags, "reading projects", listResponse.HTTPResponse, listResponse.Body, nil)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var projects []map[string]attr.Value
+	matcher := filter.Compile(ctx, config.Filter)
+	for _, project := range *listResponse.JSON200 {
+		attributes := projectListAttributes(ctx, &diags, project)
+		if matcher.OnAttributes(attributes) {
+			projects = append(projects, attributes)
+		}
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	projects = filter.Sort(projects, filter.Identity, config.SortModel, projectsMostRecentField)
+
+	stream.Results = util.ListResults(ctx, req, projects, func(attributes map[string]attr.Value, result *list.ListResult) {
+		uuid := attributes["uuid"].(types.String)
+		result.DisplayName = attributes["name"].(types.String).ValueString()
+		result.Diagnostics.Append(result.Identity.Set(ctx, uuidIdentityModel{Uuid: uuid})...)
+
+		if req.IncludeResource {
+			data := r.ReadFromAPI(ctx, &result.Diagnostics, uuid.ValueString())
+			r.copyMissingAttributes(&projectResourceModel{}, &data)
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
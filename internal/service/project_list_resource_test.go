@@ -0,0 +1,27 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestProjectListResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	lr := service.NewProjectListResource()
+	resp := &list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, resp)
+
+	if _, ok := resp.Schema.Blocks["filter"].(schema.ListNestedBlock); !ok {
+		t.Error("filter should be a ListNestedBlock")
+	}
+	for _, attr := range []string{"sort_by", "order", "limit", "most_recent"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("attribute %q should exist in schema", attr)
+		}
+	}
+}
@@ -4,16 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
 	"terraform-provider-coolify/internal/flatten"
 	"terraform-provider-coolify/internal/provider/generated/datasource_project"
 	"terraform-provider-coolify/internal/provider/generated/resource_project"
@@ -24,6 +28,7 @@ var (
 	_ resource.Resource                = &projectResource{}
 	_ resource.ResourceWithConfigure   = &projectResource{}
 	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.ResourceWithIdentity    = &projectResource{}
 )
 
 func NewProjectResource() resource.Resource {
@@ -34,6 +39,12 @@ type projectResource struct {
 	client *api.ClientWithResponses
 }
 
+type projectResourceModel struct {
+	resource_project.ProjectModel
+	ForceDestroy types.Bool     `tfsdk:"force_destroy"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *projectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
@@ -53,6 +64,22 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 	for _, attr := range validateNonEmptyStrings {
 		makeResourceAttributeNonEmpty(resp.Schema.Attributes, attr)
 	}
+
+	resp.Schema.Attributes["force_destroy"] = schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Description: "Delete all applications, databases and services in the project's environments, including their volumes, when the project is destroyed." +
+			" Without it, destroying a project that still has resources fails and lists them. Default: `false`.",
+	}
+	if resp.Schema.Blocks == nil {
+		resp.Schema.Blocks = map[string]schema.Block{}
+	}
+	resp.Schema.Blocks["timeouts"] = forceDestroyTimeoutsBlock(ctx)
+}
+
+func (r *projectResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("UUID of the project.")
 }
 
 func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -60,7 +87,7 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_project.ProjectModel
+	var plan projectResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -84,19 +111,17 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if createResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating project",
-			fmt.Sprintf("Received %s creating project. Details: %s", createResp.Status(), createResp.Body),
-		)
+		util.AddAPIError(&resp.Diagnostics, "creating project", createResp.HTTPResponse, createResp.Body, plan)
 		return
 	}
 
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, *createResp.JSON201.Uuid)
 	r.copyMissingAttributes(&plan, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentityModel{Uuid: data.Uuid})...)
 }
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_project.ProjectModel
+	var state projectResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -112,13 +137,17 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, state.Uuid.ValueString())
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
 	r.copyMissingAttributes(&state, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentityModel{Uuid: data.Uuid})...)
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan resource_project.ProjectModel
-	var state resource_project.ProjectModel
+	var plan projectResourceModel
+	var state projectResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -155,19 +184,18 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if updateResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code updating project",
-			fmt.Sprintf("Received %s updating project: uuid=%s. Details: %s", updateResp.Status(), uuid, updateResp.Body))
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("updating project: uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, plan)
 		return
 	}
 
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, uuid)
 	r.copyMissingAttributes(&plan, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentityModel{Uuid: data.Uuid})...)
 }
 
 func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_project.ProjectModel
+	var state projectResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -176,53 +204,93 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	uuid := state.Uuid.ValueString()
+	blocking := projectBlockingResources(ctx, r.client, &resp.Diagnostics, uuid)
+	// Already deleted outside of Terraform
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(blocking) > 0 {
+		if !state.ForceDestroy.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Project still has resources",
+				fmt.Sprintf("Project %s (uuid=%s) cannot be deleted while its environments contain the following resources:\n%s\n"+
+					"Delete them first, or set `force_destroy = true` to delete them together with the project.",
+					state.Name.ValueString(), uuid, formatBlockingResources(blocking)),
+			)
+			return
+		}
+
+		deleteBlockingResources(ctx, r.client, &resp.Diagnostics, blocking)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		timeout, diags := state.Timeouts.Delete(ctx, consts.DEFAULT_FORCE_DESTROY_TIMEOUT*time.Second)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		waitForNoBlockingResources(ctx, &resp.Diagnostics, timeout, func(diags *diag.Diagnostics) []blockingResource {
+			return projectBlockingResources(ctx, r.client, diags, uuid)
+		})
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Debug(ctx, "Deleting project", map[string]interface{}{
-		"uuid": state.Uuid.ValueString(),
+		"uuid": uuid,
 	})
-	deleteResp, err := r.client.DeleteProjectByUuidWithResponse(ctx, state.Uuid.ValueString())
+	deleteResp, err := r.client.DeleteProjectByUuidWithResponse(ctx, uuid)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
 		return
 	}
 
-	if deleteResp.JSON200 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting project",
-			fmt.Sprintf("Received %s deleting project: %s. Details: %s", deleteResp.Status(), state, deleteResp.Body))
+	if deleteResp.JSON200 == nil && deleteResp.StatusCode() != http.StatusNotFound {
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("deleting project: uuid=%s", state.Uuid.ValueString()), deleteResp.HTTPResponse, deleteResp.Body, nil)
 		return
 	}
 }
 
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("uuid"), path.Root("uuid"), req, resp)
 }
 
 func (r *projectResource) copyMissingAttributes(
-	plan *resource_project.ProjectModel,
-	data *resource_project.ProjectModel,
+	plan *projectResourceModel,
+	data *projectResourceModel,
 ) {
-
+	data.ForceDestroy = plan.ForceDestroy
+	data.Timeouts = withForceDestroyTimeouts(plan.Timeouts)
+	if plan.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
 }
 
 func (r *projectResource) ReadFromAPI(
 	ctx context.Context,
 	diags *diag.Diagnostics,
 	uuid string,
-) resource_project.ProjectModel {
+) projectResourceModel {
 	readResp, err := r.client.GetProjectByUuidWithResponse(ctx, uuid)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error reading project: uuid=%s", uuid),
 			err.Error(),
 		)
-		return resource_project.ProjectModel{}
+		return projectResourceModel{}
 	}
 
 	if readResp.StatusCode() != http.StatusOK {
-		diags.AddError(
-			"Unexpected HTTP status code reading project",
-			fmt.Sprintf("Received %s for project: uuid=%s. Details: %s", readResp.Status(), uuid, readResp.Body))
-		return resource_project.ProjectModel{}
+		util.AddAPIError(diags, fmt.Sprintf("reading project: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
+		return projectResourceModel{}
 	}
 
 	return r.ApiToModel(ctx, diags, readResp.JSON200)
@@ -232,7 +300,7 @@ func (r *projectResource) ApiToModel(
 	ctx context.Context,
 	diags *diag.Diagnostics,
 	response *api.Project,
-) resource_project.ProjectModel {
+) projectResourceModel {
 	var elements []attr.Value
 	for _, env := range *response.Environments {
 		attributes := map[string]attr.Value{
@@ -253,11 +321,13 @@ func (r *projectResource) ApiToModel(
 	dataList, diag := types.ListValueFrom(ctx, datasource_project.EnvironmentsValue{}.Type(ctx), elements)
 	diags.Append(diag...)
 
-	return resource_project.ProjectModel{
-		Description:  flatten.String(response.Description),
-		Environments: dataList,
-		Id:           flatten.Int64(response.Id),
-		Name:         flatten.String(response.Name),
-		Uuid:         flatten.String(response.Uuid),
+	return projectResourceModel{
+		ProjectModel: resource_project.ProjectModel{
+			Description:  flatten.String(response.Description),
+			Environments: dataList,
+			Id:           flatten.Int64(response.Id),
+			Name:         flatten.String(response.Name),
+			Uuid:         flatten.String(response.Uuid),
+		},
 	}
 }
@@ -28,6 +28,7 @@ func TestAccProjectResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resName, "name", "TerraformAccTest"),
 					resource.TestCheckResourceAttr(resName, "description", "Terraform acceptance testing"),
+					resource.TestCheckResourceAttr(resName, "force_destroy", "false"),
 					// Verify dynamic values
 					resource.TestCheckResourceAttrSet(resName, "uuid"),
 					resource.TestCheckResourceAttrSet(resName, "id"),
@@ -41,6 +42,17 @@ func TestAccProjectResource(t *testing.T) {
 					return s.RootModule().Resources[resName].Primary.Attributes["uuid"], nil
 				},
 			},
+			{ // ImportState by identity testing
+				Config: `
+				resource "coolify_project" "test" {
+					name        = "TerraformAccTest"
+					description = "Terraform acceptance testing"
+				}
+				`,
+				ResourceName:    resName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			{ // Update and Read testing
 				Config: `
 				resource "coolify_project" "test" {
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -30,11 +29,13 @@ type projectsDataSource struct {
 }
 
 type projectsDataSourceWithFilterModel struct {
-	datasource_projects.ProjectsModel
-	Filter []filter.BlockModel `tfsdk:"filter"`
+	Projects types.List          `tfsdk:"projects"`
+	Filter   []filter.BlockModel `tfsdk:"filter"`
+	filter.SortModel
 }
 
-var projectsFilterNames = []string{"id", "uuid", "name", "description"}
+// Projects do not expose their creation time, IDs are incremental
+var projectsMostRecentField = "id"
 
 func (d *projectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_projects"
@@ -58,9 +59,7 @@ func (d *projectsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		}
 	}
 
-	resp.Schema.Blocks = map[string]schema.Block{
-		"filter": filter.CreateDatasourceFilter(projectsFilterNames),
-	}
+	makeDataSourceListFilterable(&resp.Schema, "projects", projectsMostRecentField)
 }
 
 func (d *projectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -86,14 +85,11 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	if listResponse.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading projects",
-			fmt.Sprintf("Received %s for projects. Details: %s", listResponse.Status(), listResponse.Body),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading projects", listResponse.HTTPResponse, listResponse.Body, nil)
 		return
 	}
 
-	state, diag := d.apiToModel(ctx, listResponse.JSON200, plan.Filter)
+	state, diag := d.apiToModel(ctx, listResponse.JSON200, plan.Filter, plan.SortModel)
 	resp.Diagnostics.Append(diag...)
 
 	if resp.Diagnostics.HasError() {
@@ -107,62 +103,73 @@ func (d *projectsDataSource) apiToModel(
 	ctx context.Context,
 	response *[]api.Project,
 	filters []filter.BlockModel,
+	sort filter.SortModel,
 ) (projectsDataSourceWithFilterModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var projects []attr.Value
+	var projects []map[string]attr.Value
 
+	matcher := filter.Compile(ctx, filters)
 	for _, project := range *response {
-		var envs []attr.Value
-
-		// todo: Coolify API bug, environments are not returned
-		if project.Environments != nil {
-			for _, env := range *project.Environments {
-				attributes := map[string]attr.Value{
-					"created_at":  flatten.String(env.CreatedAt),
-					"description": flatten.String(env.Description),
-					"id":          flatten.Int64(env.Id),
-					"name":        flatten.String(env.Name),
-					"project_id":  flatten.Int64(env.ProjectId),
-					"updated_at":  flatten.String(env.UpdatedAt),
-				}
-
-				data, diag := datasource_projects.NewEnvironmentsValue(
-					datasource_projects.EnvironmentsValue{}.AttributeTypes(ctx),
-					attributes)
-				diags.Append(diag...)
-				envs = append(envs, data)
-			}
-		}
-
-		envsList, diag := types.ListValueFrom(ctx, datasource_projects.EnvironmentsValue{}.Type(ctx), envs)
-		diags.Append(diag...)
-
-		attributes := map[string]attr.Value{
-			"description":  flatten.String(project.Description),
-			"environments": envsList,
-			"id":           flatten.Int64(project.Id),
-			"name":         flatten.String(project.Name),
-			"uuid":         flatten.String(project.Uuid),
-		}
+		attributes := projectListAttributes(ctx, &diags, project)
 
-		if !filter.OnAttributes(attributes, filters) {
+		if !matcher.OnAttributes(attributes) {
 			continue
 		}
 
+		projects = append(projects, attributes)
+	}
+
+	var elements []attr.Value
+	for _, attributes := range filter.Sort(projects, filter.Identity, sort, projectsMostRecentField) {
 		data, diag := datasource_projects.NewProjectsValue(
 			datasource_projects.ProjectsValue{}.AttributeTypes(ctx),
 			attributes)
 		diags.Append(diag...)
-		projects = append(projects, data)
+		elements = append(elements, data)
 	}
 
-	dataSet, diag := types.SetValue(datasource_projects.ProjectsValue{}.Type(ctx), projects)
+	dataList, diag := types.ListValue(datasource_projects.ProjectsValue{}.Type(ctx), elements)
 	diags.Append(diag...)
 
 	return projectsDataSourceWithFilterModel{
-		ProjectsModel: datasource_projects.ProjectsModel{
-			Projects: dataSet,
-		},
-		Filter: filters,
+		Projects:  dataList,
+		Filter:    filters,
+		SortModel: sort,
 	}, diags
 }
+
+// projectListAttributes returns the attributes of a listed project, which filters and sorting apply to.
+func projectListAttributes(ctx context.Context, diags *diag.Diagnostics, project api.Project) map[string]attr.Value {
+	var envs []attr.Value
+
+	// todo: Coolify API bug, environments are not returned
+	if project.Environments != nil {
+		for _, env := range *project.Environments {
+			attributes := map[string]attr.Value{
+				"created_at":  flatten.String(env.CreatedAt),
+				"description": flatten.String(env.Description),
+				"id":          flatten.Int64(env.Id),
+				"name":        flatten.String(env.Name),
+				"project_id":  flatten.Int64(env.ProjectId),
+				"updated_at":  flatten.String(env.UpdatedAt),
+			}
+
+			data, diag := datasource_projects.NewEnvironmentsValue(
+				datasource_projects.EnvironmentsValue{}.AttributeTypes(ctx),
+				attributes)
+			diags.Append(diag...)
+			envs = append(envs, data)
+		}
+	}
+
+	envsList, diag := types.ListValueFrom(ctx, datasource_projects.EnvironmentsValue{}.Type(ctx), envs)
+	diags.Append(diag...)
+
+	return map[string]attr.Value{
+		"description":  flatten.String(project.Description),
+		"environments": envsList,
+		"id":           flatten.Int64(project.Id),
+		"name":         flatten.String(project.Name),
+		"uuid":         flatten.String(project.Uuid),
+	}
+}
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/flatten"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+// resourceModel is an application, service or database with the names of its server, project and environment resolved.
+type resourceModel struct {
+	CreatedAt       types.String `tfsdk:"created_at"`
+	EnvironmentId   types.Int64  `tfsdk:"environment_id"`
+	EnvironmentName types.String `tfsdk:"environment_name"`
+	Id              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ProjectName     types.String `tfsdk:"project_name"`
+	ProjectUuid     types.String `tfsdk:"project_uuid"`
+	ServerName      types.String `tfsdk:"server_name"`
+	ServerUuid      types.String `tfsdk:"server_uuid"`
+	Status          types.String `tfsdk:"status"`
+	Type            types.String `tfsdk:"type"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	Uuid            types.String `tfsdk:"uuid"`
+}
+
+var _ filter.FilterableStructModel = resourceModel{}
+
+var resourcesMostRecentField = "created_at"
+
+func (m resourceModel) FilterAttributes() map[string]attr.Value {
+	return filter.StructAttributes(m)
+}
+
+// inventoryResource is a resource as returned by the team wide resources endpoint.
+type inventoryResource struct {
+	CreatedAt     *string `json:"created_at"`
+	EnvironmentId *int    `json:"environment_id"`
+	Id            *int    `json:"id"`
+	Name          *string `json:"name"`
+	Status        *string `json:"status"`
+	Type          *string `json:"type"`
+	UpdatedAt     *string `json:"updated_at"`
+	Uuid          *string `json:"uuid"`
+}
+
+type inventoryServer struct {
+	Uuid          string
+	Name          string
+	Ip            string
+	ResourceUuids []string
+}
+
+type inventoryProject struct {
+	Uuid         string
+	Name         string
+	Description  types.String
+	Environments []inventoryEnvironment
+}
+
+type inventoryEnvironment struct {
+	Id          int64
+	Name        string
+	ProjectUuid string
+	ProjectName string
+}
+
+// inventoryConcurrency is the number of concurrent API calls used to build the inventory, unless configured otherwise.
+var inventoryConcurrency int64 = 4
+
+// forEachConcurrently calls fn for the index of each item, running at most `workers` calls at a time.
+// Each call gets its own diagnostics, which are merged once all calls are done.
+func forEachConcurrently(diags *diag.Diagnostics, count int, workers int64, fn func(i int, diags *diag.Diagnostics)) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]diag.Diagnostics, count)
+	queue := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := int64(0); w < workers && w < int64(count); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				fn(i, &results[i])
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	for _, result := range results {
+		diags.Append(result...)
+	}
+}
+
+// resourcesFromBody extracts the resources from a raw resources response.
+// The response is documented as a string, so anything unexpected is ignored.
+func resourcesFromBody(body []byte) []inventoryResource {
+	var resources []inventoryResource
+	if err := json.Unmarshal(body, &resources); err != nil {
+		return nil
+	}
+	return resources
+}
+
+func (m resourceModel) FromInventory(
+	res inventoryResource,
+	servers map[string]inventoryServer,
+	environments map[int64]inventoryEnvironment,
+) resourceModel {
+	model := resourceModel{
+		CreatedAt:       flatten.String(res.CreatedAt),
+		EnvironmentId:   flatten.Int64(res.EnvironmentId),
+		EnvironmentName: types.StringNull(),
+		Id:              flatten.Int64(res.Id),
+		Name:            flatten.String(res.Name),
+		ProjectName:     types.StringNull(),
+		ProjectUuid:     types.StringNull(),
+		ServerName:      types.StringNull(),
+		ServerUuid:      types.StringNull(),
+		Status:          flatten.String(res.Status),
+		Type:            flatten.String(res.Type),
+		UpdatedAt:       flatten.String(res.UpdatedAt),
+		Uuid:            flatten.String(res.Uuid),
+	}
+
+	if res.Uuid != nil {
+		if server, ok := servers[*res.Uuid]; ok {
+			model.ServerUuid = types.StringValue(server.Uuid)
+			model.ServerName = types.StringValue(server.Name)
+		}
+	}
+
+	if res.EnvironmentId != nil {
+		if env, ok := environments[int64(*res.EnvironmentId)]; ok {
+			model.EnvironmentName = types.StringValue(env.Name)
+			model.ProjectUuid = types.StringValue(env.ProjectUuid)
+			model.ProjectName = types.StringValue(env.ProjectName)
+		}
+	}
+
+	return model
+}
+
+func listInventoryResources(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+) []inventoryResource {
+	resourcesResp, err := client.ListResourcesWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading resources", err.Error())
+		return nil
+	}
+
+	if resourcesResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, "reading resources", resourcesResp.HTTPResponse, resourcesResp.Body, nil)
+		return nil
+	}
+
+	return resourcesFromBody(resourcesResp.Body)
+}
+
+// listInventoryServers lists the servers with the UUIDs of the resources deployed to them.
+func listInventoryServers(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	workers int64,
+) []inventoryServer {
+	serversResp, err := client.ListServersWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading servers", err.Error())
+		return nil
+	}
+
+	if serversResp.StatusCode() != http.StatusOK || serversResp.JSON200 == nil {
+		util.AddAPIError(diags, "reading servers", serversResp.HTTPResponse, serversResp.Body, nil)
+		return nil
+	}
+
+	servers := make([]inventoryServer, len(*serversResp.JSON200))
+	forEachConcurrently(diags, len(servers), workers, func(i int, diags *diag.Diagnostics) {
+		sv := (*serversResp.JSON200)[i]
+		servers[i] = inventoryServer{
+			Uuid: flatten.String(sv.Uuid).ValueString(),
+			Name: flatten.String(sv.Name).ValueString(),
+			Ip:   flatten.String(sv.Ip).ValueString(),
+		}
+
+		for _, res := range serverBlockingResources(ctx, client, diags, servers[i].Uuid) {
+			servers[i].ResourceUuids = append(servers[i].ResourceUuids, res.Uuid)
+		}
+	})
+	return servers
+}
+
+// serversByResource maps the UUID of each resource to the server it is deployed to.
+func serversByResource(servers []inventoryServer) map[string]inventoryServer {
+	byResource := map[string]inventoryServer{}
+	for _, server := range servers {
+		for _, uuid := range server.ResourceUuids {
+			byResource[uuid] = server
+		}
+	}
+	return byResource
+}
+
+// listInventoryProjects lists the projects with their environments.
+func listInventoryProjects(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	workers int64,
+) []inventoryProject {
+	projectsResp, err := client.ListProjectsWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading projects", err.Error())
+		return nil
+	}
+
+	if projectsResp.StatusCode() != http.StatusOK || projectsResp.JSON200 == nil {
+		util.AddAPIError(diags, "reading projects", projectsResp.HTTPResponse, projectsResp.Body, nil)
+		return nil
+	}
+
+	projects := make([]inventoryProject, len(*projectsResp.JSON200))
+	forEachConcurrently(diags, len(projects), workers, func(i int, diags *diag.Diagnostics) {
+		project := (*projectsResp.JSON200)[i]
+		projects[i] = inventoryProject{
+			Uuid:        flatten.String(project.Uuid).ValueString(),
+			Name:        flatten.String(project.Name).ValueString(),
+			Description: flatten.String(project.Description),
+		}
+		uuid := projects[i].Uuid
+
+		// todo: Coolify API bug, environments are not returned when listing projects
+		projectResp, err := client.GetProjectByUuidWithResponse(ctx, uuid)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error reading project: uuid=%s", uuid),
+				err.Error(),
+			)
+			return
+		}
+
+		if projectResp.StatusCode() != http.StatusOK || projectResp.JSON200 == nil {
+			util.AddAPIError(diags, fmt.Sprintf("reading project: uuid=%s", uuid), projectResp.HTTPResponse, projectResp.Body, nil)
+			return
+		}
+
+		if projectResp.JSON200.Environments == nil {
+			return
+		}
+
+		for _, env := range *projectResp.JSON200.Environments {
+			if env.Id == nil {
+				continue
+			}
+			projects[i].Environments = append(projects[i].Environments, inventoryEnvironment{
+				Id:          int64(*env.Id),
+				Name:        flatten.String(env.Name).ValueString(),
+				ProjectUuid: uuid,
+				ProjectName: projects[i].Name,
+			})
+		}
+	})
+	return projects
+}
+
+// environmentsById maps the ID of each environment to its name and project.
+func environmentsById(projects []inventoryProject) map[int64]inventoryEnvironment {
+	environments := map[int64]inventoryEnvironment{}
+	for _, project := range projects {
+		for _, env := range project.Environments {
+			environments[env.Id] = env
+		}
+	}
+	return environments
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/testutils"
+)
+
+func TestResourcesFromBody(t *testing.T) {
+	resources := resourcesFromBody([]byte(`[
+		{"uuid": "app1", "name": "web", "type": "application", "status": "running:healthy", "environment_id": 1},
+		{"uuid": "pg1", "name": "db", "type": "standalone-postgresql", "status": "exited:unhealthy", "environment_id": 2, "postgres_password": "secret"}
+	]`))
+
+	if assert.Len(t, resources, 2) {
+		assert.Equal(t, "app1", *resources[0].Uuid)
+		assert.Equal(t, "standalone-postgresql", *resources[1].Type)
+		assert.Equal(t, 2, *resources[1].EnvironmentId)
+	}
+
+	assert.Empty(t, resourcesFromBody([]byte(`"not a list"`)))
+}
+
+func TestResourceModel_FromInventory(t *testing.T) {
+	uuid, status, envId := "app1", "exited:unhealthy", 3
+	servers := map[string]inventoryServer{
+		"app1": {Uuid: "sv1", Name: "localhost"},
+	}
+	environments := map[int64]inventoryEnvironment{
+		3: {Id: 3, Name: "production", ProjectUuid: "pr1", ProjectName: "website"},
+	}
+
+	model := resourceModel{}.FromInventory(inventoryResource{Uuid: &uuid, Status: &status, EnvironmentId: &envId}, servers, environments)
+	assert.Equal(t, types.StringValue("localhost"), model.ServerName)
+	assert.Equal(t, types.StringValue("sv1"), model.ServerUuid)
+	assert.Equal(t, types.StringValue("production"), model.EnvironmentName)
+	assert.Equal(t, types.StringValue("website"), model.ProjectName)
+	assert.Equal(t, types.StringValue("pr1"), model.ProjectUuid)
+
+	assert.True(t, filter.Compile(context.Background(), []filter.BlockModel{
+		{
+			Name:   types.StringValue("environment_name"),
+			Values: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("production")}),
+		},
+		{
+			Name:   types.StringValue("status"),
+			Values: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("exited")}),
+			Match:  types.StringValue("prefix"),
+		},
+	}).OnStruct(model))
+
+	// Unknown servers and environments are left null
+	model = resourceModel{}.FromInventory(inventoryResource{Uuid: &uuid}, nil, nil)
+	assert.True(t, model.ServerName.IsNull())
+	assert.True(t, model.EnvironmentName.IsNull())
+}
+
+func TestResourceModel_Attributes(t *testing.T) {
+	model := resourceModel{}
+
+	expected := testutils.GenerateAttrTypesFromStruct(t, model)
+	actual := model.FilterAttributes()
+
+	// Every filter name derived from the list schema must be filterable
+	resp := &datasource.SchemaResponse{}
+	NewResourcesDataSource().Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	filterNames := filter.NamesFromSchema(resp.Schema.Attributes["resources"].(schema.ListNestedAttribute).NestedObject.Attributes)
+	assert.NotEmpty(t, filterNames)
+
+	for _, key := range filterNames {
+		_, exists := actual[key]
+		assert.True(t, exists, "Key %q should exist in actual attributes", key)
+	}
+
+	for key := range actual {
+		_, exists := expected[key]
+		assert.True(t, exists, "Key %q should exist in expected attributes", key)
+	}
+}
+
+func TestForEachConcurrently(t *testing.T) {
+	var running, maxRunning int64
+	var mu sync.Mutex
+	results := make([]int, 20)
+
+	var diags diag.Diagnostics
+	forEachConcurrently(&diags, len(results), 3, func(i int, diags *diag.Diagnostics) {
+		mu.Lock()
+		running++
+		maxRunning = max(maxRunning, running)
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+		results[i] = i * 2
+		if i == 7 {
+			diags.AddError("failed", "item 7")
+		}
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+	})
+
+	assert.LessOrEqual(t, maxRunning, int64(3))
+	for i, result := range results {
+		assert.Equal(t, i*2, result)
+	}
+	assert.Equal(t, 1, diags.ErrorsCount())
+
+	// No items, no workers
+	forEachConcurrently(&diags, 0, 3, func(i int, diags *diag.Diagnostics) {
+		t.Fatal("should not be called")
+	})
+}
+
+func TestBuildInventory(t *testing.T) {
+	str := func(s string) *string { return &s }
+	num := func(i int) *int { return &i }
+
+	resources := []inventoryResource{
+		{Uuid: str("app1"), Name: str("web"), Type: str("application"), EnvironmentId: num(1)},
+		{Uuid: str("pg1"), Name: str("db"), Type: str("standalone-postgresql"), EnvironmentId: num(2)},
+		{Uuid: str("orphan"), Name: str("lost"), Type: str("service"), EnvironmentId: num(9)},
+	}
+	servers := []inventoryServer{
+		{Uuid: "sv1", Name: "localhost", Ip: "127.0.0.1", ResourceUuids: []string{"app1", "pg1"}},
+		{Uuid: "sv2", Name: "empty", Ip: "10.0.0.2"},
+	}
+	projects := []inventoryProject{
+		{Uuid: "pr1", Name: "website", Environments: []inventoryEnvironment{
+			{Id: 1, Name: "production", ProjectUuid: "pr1", ProjectName: "website"},
+			{Id: 2, Name: "staging", ProjectUuid: "pr1", ProjectName: "website"},
+		}},
+	}
+
+	var diags diag.Diagnostics
+	state := buildInventory(&diags, resources, servers, projects)
+	assert.Empty(t, diags)
+
+	if assert.Len(t, state.Servers, 2) {
+		assert.Equal(t, types.StringValue("localhost"), state.Servers[0].Name)
+		if assert.Len(t, state.Servers[0].Projects, 1) {
+			envs := state.Servers[0].Projects[0].Environments
+			if assert.Len(t, envs, 2) {
+				assert.Equal(t, types.StringValue("production"), envs[0].Name)
+				assert.Equal(t, types.StringValue("app1"), envs[0].Resources[0].Uuid)
+				assert.Equal(t, types.StringValue("pg1"), envs[1].Resources[0].Uuid)
+			}
+		}
+		assert.Empty(t, state.Servers[1].Projects)
+	}
+
+	assert.Equal(t, types.StringValue("sv2"), state.ServersByName["empty"].Uuid)
+	assert.Equal(t, types.StringValue("10.0.0.2"), state.ServersByUuid["sv2"].Ip)
+	assert.Equal(t, types.StringValue("pr1"), state.ProjectsByName["website"].Uuid)
+	assert.True(t, state.ProjectsByName["website"].Description.IsNull())
+	assert.Equal(t, types.Int64Value(2), state.ProjectsByUuid["pr1"].Environments["staging"])
+	assert.Equal(t, types.StringValue("pg1"), state.ResourcesByName["website/staging/db"].Uuid)
+	assert.Equal(t, types.StringValue("localhost"), state.ResourcesByUuid["app1"].ServerName)
+
+	// Resources which can not be placed are still indexed by UUID
+	assert.Contains(t, state.ResourcesByUuid, "orphan")
+	assert.Len(t, state.ResourcesByName, 2)
+}
+
+func TestBuildInventoryNameCollisions(t *testing.T) {
+	str := func(s string) *string { return &s }
+	num := func(i int) *int { return &i }
+
+	resources := []inventoryResource{
+		{Uuid: str("app1"), Name: str("web"), Type: str("application"), EnvironmentId: num(1)},
+		{Uuid: str("app2"), Name: str("web"), Type: str("application"), EnvironmentId: num(1)},
+	}
+	servers := []inventoryServer{
+		{Uuid: "sv1", Name: "worker", ResourceUuids: []string{"app1", "app2"}},
+		{Uuid: "sv2", Name: "worker"},
+	}
+	projects := []inventoryProject{
+		{Uuid: "pr1", Name: "website", Description: types.StringValue("first"), Environments: []inventoryEnvironment{
+			{Id: 1, Name: "production", ProjectUuid: "pr1", ProjectName: "website"},
+		}},
+		{Uuid: "pr2", Name: "website", Description: types.StringValue("second")},
+	}
+
+	var diags diag.Diagnostics
+	state := buildInventory(&diags, resources, servers, projects)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 3, diags.WarningsCount())
+
+	// The first entry listed wins, every entry is still indexed by UUID
+	assert.Equal(t, types.StringValue("sv1"), state.ServersByName["worker"].Uuid)
+	assert.Equal(t, types.StringValue("pr1"), state.ProjectsByName["website"].Uuid)
+	assert.Equal(t, types.StringValue("first"), state.ProjectsByName["website"].Description)
+	assert.Equal(t, types.StringValue("app1"), state.ResourcesByName["website/production/web"].Uuid)
+	assert.Len(t, state.ServersByUuid, 2)
+	assert.Len(t, state.ProjectsByUuid, 2)
+	assert.Len(t, state.ResourcesByUuid, 2)
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var _ datasource.DataSource = &resourcesDataSource{}
+var _ datasource.DataSourceWithConfigure = &resourcesDataSource{}
+
+type resourcesDataSourceModel struct {
+	Resources []resourceModel     `tfsdk:"resources"`
+	Filter    []filter.BlockModel `tfsdk:"filter"`
+	filter.SortModel
+}
+
+func NewResourcesDataSource() datasource.DataSource {
+	return &resourcesDataSource{}
+}
+
+type resourcesDataSource struct {
+	client *api.ClientWithResponses
+}
+
+func (d *resourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+// resourceAttributes returns the attributes of a resource, shared with the inventory data source.
+func resourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date and time the resource was created.",
+		},
+		"environment_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The identifier of the environment the resource belongs to.",
+		},
+		"environment_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the environment the resource belongs to.",
+		},
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The identifier of the resource, unique per resource type.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the resource.",
+		},
+		"project_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the project the resource belongs to.",
+		},
+		"project_uuid": schema.StringAttribute{
+			Computed:    true,
+			Description: "The UUID of the project the resource belongs to.",
+		},
+		"server_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the server the resource is deployed to.",
+		},
+		"server_uuid": schema.StringAttribute{
+			Computed:    true,
+			Description: "The UUID of the server the resource is deployed to.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the resource, ie `running:healthy` or `exited:unhealthy`.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the resource, ie `application`, `service` or `standalone-postgresql`.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date and time the resource was last updated.",
+		},
+		"uuid": schema.StringAttribute{
+			Computed:    true,
+			Description: "The UUID of the resource.",
+		},
+	}
+}
+
+func (d *resourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get every application, service and database of the team, with the names of their server, project and environment." +
+			"\nNOTE: Resolving names requires an additional API call per server and per project.",
+		Attributes: map[string]schema.Attribute{
+			"resources": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: resourceAttributes(),
+				},
+			},
+		},
+	}
+
+	makeDataSourceListFilterable(&resp.Schema, "resources", resourcesMostRecentField)
+}
+
+func (d *resourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	util.ProviderDataFromDataSourceConfigureRequest(req, &d.client, resp)
+}
+
+func (d *resourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan resourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources := listInventoryResources(ctx, d.client, &resp.Diagnostics)
+	servers := serversByResource(listInventoryServers(ctx, d.client, &resp.Diagnostics, inventoryConcurrency))
+	environments := environmentsById(listInventoryProjects(ctx, d.client, &resp.Diagnostics, inventoryConcurrency))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diag := d.apiToModel(ctx, resources, servers, environments, plan.Filter, plan.SortModel)
+	resp.Diagnostics.Append(diag...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *resourcesDataSource) apiToModel(
+	ctx context.Context,
+	resources []inventoryResource,
+	servers map[string]inventoryServer,
+	environments map[int64]inventoryEnvironment,
+	filters []filter.BlockModel,
+	sort filter.SortModel,
+) (resourcesDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var resourceValues []resourceModel
+
+	matcher := filter.Compile(ctx, filters)
+	for _, res := range resources {
+		model := resourceModel{}.FromInventory(res, servers, environments)
+
+		if !matcher.OnStruct(model) {
+			continue
+		}
+
+		resourceValues = append(resourceValues, model)
+	}
+
+	resourceValues = filter.Sort(resourceValues, resourceModel.FilterAttributes, sort, resourcesMostRecentField)
+
+	return resourcesDataSourceModel{
+		Resources: resourceValues,
+		Filter:    filters,
+		SortModel: sort,
+	}, diags
+}
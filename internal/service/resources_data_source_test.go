@@ -0,0 +1,61 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestAccResourcesDataSource(t *testing.T) {
+	resName := "data.coolify_resources.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "coolify_resources" "test" {
+					filter {
+						name   = "uuid"
+						values = ["` + acctest.ApplicationUUID + `"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resName, "resources.0.type", "application"),
+					resource.TestCheckResourceAttr(resName, "resources.0.server_uuid", acctest.ServerUUID),
+					resource.TestCheckResourceAttr(resName, "resources.0.project_uuid", acctest.ProjectUUID),
+					resource.TestCheckResourceAttr(resName, "resources.0.environment_name", acctest.EnvironmentName),
+				),
+			},
+			{
+				Config: `
+				data "coolify_resources" "test" {
+					filter {
+						name   = "type"
+						values = ["service"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "resources.0.type", "service"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourcesDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := service.NewResourcesDataSource()
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.Contains(t, resp.Schema.Attributes, "resources")
+	assert.Contains(t, resp.Schema.Blocks, "filter")
+}
@@ -2,14 +2,16 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 
 	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
 	"terraform-provider-coolify/internal/flatten"
 	"terraform-provider-coolify/internal/provider/generated/datasource_server"
 	"terraform-provider-coolify/internal/provider/util"
@@ -17,6 +19,7 @@ import (
 
 var _ datasource.DataSource = &serverDataSource{}
 var _ datasource.DataSourceWithConfigure = &serverDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &serverDataSource{}
 
 func NewServerDataSource() datasource.DataSource {
 	return &serverDataSource{}
@@ -32,7 +35,21 @@ func (d *serverDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (d *serverDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = datasource_server.ServerDataSourceSchema(ctx)
-	resp.Schema.Description = "Get a Coolify server by `uuid`."
+	resp.Schema.Description = "Get a Coolify server by exactly one of `uuid`, `name` or `ip`."
+
+	for _, attr := range []string{"uuid", "name", "ip"} {
+		makeDataSourceAttributeLookup(resp.Schema.Attributes, attr)
+	}
+}
+
+func (d *serverDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uuid"),
+			path.MatchRoot("name"),
+			path.MatchRoot("ip"),
+		),
+	}
 }
 
 func (d *serverDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -49,7 +66,15 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	response, err := d.client.GetServerByUuidWithResponse(ctx, plan.Uuid.ValueString())
+	uuid := plan.Uuid.ValueString()
+	if plan.Uuid.IsNull() {
+		uuid = d.lookupUuid(ctx, &resp.Diagnostics, plan)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response, err := d.client.GetServerByUuidWithResponse(ctx, uuid)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading server", err.Error(),
@@ -58,10 +83,7 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	if response.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading server",
-			fmt.Sprintf("Received %s for server. Details: %s", response.Status(), string(response.Body)),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading server", response.HTTPResponse, response.Body, nil)
 		return
 	}
 
@@ -70,6 +92,42 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// lookupUuid finds the UUID of the only server matching the name or IP.
+func (d *serverDataSource) lookupUuid(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	plan datasource_server.ServerModel,
+) string {
+	listResp, err := d.client.ListServersWithResponse(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading servers", err.Error(),
+		)
+		return ""
+	}
+
+	if listResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, "reading servers", listResp.HTTPResponse, listResp.Body, nil)
+		return ""
+	}
+
+	lookup := filter.FromLookup(map[string]attr.Value{
+		"name": plan.Name,
+		"ip":   plan.Ip,
+	})
+	matcher := filter.Compile(ctx, lookup)
+	server, ok := filter.SelectOne(diags, "server", lookup, *listResp.JSON200, func(item api.Server) bool {
+		return matcher.OnAttributes(map[string]attr.Value{
+			"name": flatten.String(item.Name),
+			"ip":   flatten.String(item.Ip),
+		})
+	})
+	if !ok {
+		return ""
+	}
+	return flatten.String(server.Uuid).ValueString()
+}
+
 func (d *serverDataSource) ApiToModel(
 	ctx context.Context,
 	diags *diag.Diagnostics,
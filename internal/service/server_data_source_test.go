@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -29,6 +30,23 @@ func TestAccServerDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "settings.id", "1"),
 				),
 			},
+			// With IP
+			{
+				Config: `data "coolify_server" "test" {
+					ip = "host.docker.internal"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "uuid", acctest.ServerUUID),
+					resource.TestCheckResourceAttr(resName, "ip", "host.docker.internal"),
+				),
+			},
+			// Without a match
+			{
+				Config: `data "coolify_server" "test" {
+					name = "does-not-exist"
+				}`,
+				ExpectError: regexp.MustCompile(`No matching server found`),
+			},
 		},
 	})
 }
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -59,10 +58,7 @@ func (d *serverDomainsDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	if response.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading server domains",
-			fmt.Sprintf("Received %s for server domains. Details: %s", response.Status(), string(response.Body)),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading server domains", response.HTTPResponse, response.Body, nil)
 		return
 	}
 
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ list.ListResource              = &serverResource{}
+	_ list.ListResourceWithConfigure = &serverResource{}
+)
+
+func NewServerListResource() list.ListResource {
+	return &serverResource{}
+}
+
+func (r *serverResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceSchema(ctx, &resp.Diagnostics,
+		"List Coolify servers to import, with the same filters as the `coolify_servers` data source.",
+		NewServersDataSource(), "servers", serversMostRecentField)
+}
+
+func (r *serverResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config filter.ListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listResponse, err := r.client.ListServersWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Error reading servers", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if listResponse.StatusCode() != http.StatusOK || listResponse.JSON200 == nil {
+		util.AddAPIError(&diags, "reading servers", listResponse.HTTPResponse, listResponse.Body, nil)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var servers []map[string]attr.Value
+	matcher := filter.Compile(ctx, config.Filter)
+	for _, sv := range *listResponse.JSON200 {
+		attributes := serverListAttributes(ctx, &diags, sv)
+		if matcher.OnAttributes(attributes) {
+			servers = append(servers, attributes)
+		}
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	servers = filter.Sort(servers, filter.Identity, config.SortModel, serversMostRecentField)
+
+	stream.Results = util.ListResults(ctx, req, servers, func(attributes map[string]attr.Value, result *list.ListResult) {
+		uuid := attributes["uuid"].(types.String)
+		result.DisplayName = attributes["name"].(types.String).ValueString()
+		result.Diagnostics.Append(result.Identity.Set(ctx, uuidIdentityModel{Uuid: uuid})...)
+
+		if req.IncludeResource {
+			data := r.ReadFromAPI(ctx, &result.Diagnostics, uuid.ValueString())
+			r.copyMissingAttributes(&serverResourceModel{}, &data)
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
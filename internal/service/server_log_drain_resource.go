@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/expand"
+	"terraform-provider-coolify/internal/flatten"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ resource.Resource                     = &serverLogDrainResource{}
+	_ resource.ResourceWithConfigure        = &serverLogDrainResource{}
+	_ resource.ResourceWithImportState      = &serverLogDrainResource{}
+	_ resource.ResourceWithIdentity         = &serverLogDrainResource{}
+	_ resource.ResourceWithConfigValidators = &serverLogDrainResource{}
+)
+
+func NewServerLogDrainResource() resource.Resource {
+	return &serverLogDrainResource{}
+}
+
+type serverLogDrainResource struct {
+	client *api.ClientWithResponses
+}
+
+type serverLogDrainResourceModel struct {
+	ServerUuid types.String                  `tfsdk:"server_uuid"`
+	Axiom      *serverLogDrainAxiomModel     `tfsdk:"axiom"`
+	NewRelic   *serverLogDrainNewRelicModel  `tfsdk:"new_relic"`
+	Highlight  *serverLogDrainHighlightModel `tfsdk:"highlight"`
+	Custom     *serverLogDrainCustomModel    `tfsdk:"custom"`
+}
+
+type serverLogDrainAxiomModel struct {
+	ApiKey      types.String `tfsdk:"api_key"`
+	DatasetName types.String `tfsdk:"dataset_name"`
+}
+
+type serverLogDrainNewRelicModel struct {
+	LicenseKey types.String `tfsdk:"license_key"`
+	BaseUri    types.String `tfsdk:"base_uri"`
+}
+
+type serverLogDrainHighlightModel struct {
+	ProjectId types.String `tfsdk:"project_id"`
+}
+
+type serverLogDrainCustomModel struct {
+	Config       types.String `tfsdk:"config"`
+	ConfigParser types.String `tfsdk:"config_parser"`
+}
+
+func (r *serverLogDrainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_log_drain"
+}
+
+func (r *serverLogDrainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	nonEmpty := []validator.String{stringvalidator.LengthAtLeast(1)}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage the log drain of a Coolify server. Exactly one of `axiom`, `new_relic`, `highlight` or `custom` must be configured." +
+			"\n**NOTE:** Coolify supports a single log drain per server. Destroying this resource disables all log drains on the server.",
+		Attributes: map[string]schema.Attribute{
+			"server_uuid": schema.StringAttribute{
+				Required:      true,
+				Description:   "UUID of the server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"axiom": schema.SingleNestedBlock{
+				Description: "Send logs to [Axiom](https://axiom.co/).",
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "Axiom API key.",
+						Validators:  nonEmpty,
+					},
+					"dataset_name": schema.StringAttribute{
+						Required:    true,
+						Description: "Axiom dataset name.",
+						Validators:  nonEmpty,
+					},
+				},
+			},
+			"new_relic": schema.SingleNestedBlock{
+				Description: "Send logs to [New Relic](https://newrelic.com/).",
+				Attributes: map[string]schema.Attribute{
+					"license_key": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "New Relic license key.",
+						Validators:  nonEmpty,
+					},
+					"base_uri": schema.StringAttribute{
+						Required:    true,
+						Description: "New Relic log API endpoint, ie `https://log-api.newrelic.com/log/v1`.",
+						Validators:  nonEmpty,
+					},
+				},
+			},
+			"highlight": schema.SingleNestedBlock{
+				Description: "Send logs to [Highlight](https://highlight.io/).",
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						Required:    true,
+						Description: "Highlight project ID.",
+						Validators:  nonEmpty,
+					},
+				},
+			},
+			"custom": schema.SingleNestedBlock{
+				Description: "Send logs to a custom [Fluent Bit](https://fluentbit.io/) output.",
+				Attributes: map[string]schema.Attribute{
+					"config": schema.StringAttribute{
+						Required:    true,
+						Description: "Fluent Bit output configuration.",
+						Validators:  nonEmpty,
+					},
+					"config_parser": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Fluent Bit parser configuration. Defaults to the parser configuration set by Coolify.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serverLogDrainResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("axiom"),
+			path.MatchRoot("new_relic"),
+			path.MatchRoot("highlight"),
+			path.MatchRoot("custom"),
+		),
+	}
+}
+
+func (r *serverLogDrainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("server_uuid", "UUID of the server.")
+}
+
+func (r *serverLogDrainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
+}
+
+func (r *serverLogDrainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverLogDrainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateLogDrain(ctx, &resp.Diagnostics, plan.ServerUuid.ValueString(), plan.toAPI())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, plan.ServerUuid.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverIdentityModel{ServerUuid: data.ServerUuid})...)
+}
+
+func (r *serverLogDrainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverLogDrainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading server log drain", map[string]interface{}{
+		"server_uuid": state.ServerUuid.ValueString(),
+	})
+	if state.ServerUuid.ValueString() == "" {
+		resp.Diagnostics.AddError("Invalid State", "No server UUID found in state")
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, state.ServerUuid.ValueString())
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverIdentityModel{ServerUuid: data.ServerUuid})...)
+}
+
+func (r *serverLogDrainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverLogDrainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateLogDrain(ctx, &resp.Diagnostics, plan.ServerUuid.ValueString(), plan.toAPI())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, plan.ServerUuid.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverIdentityModel{ServerUuid: data.ServerUuid})...)
+}
+
+func (r *serverLogDrainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverLogDrainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Disable every drain, leaving the stored credentials untouched
+	r.updateLogDrain(ctx, &resp.Diagnostics, state.ServerUuid.ValueString(), serverLogDrainResourceModel{}.toAPI())
+}
+
+func (r *serverLogDrainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("server_uuid"), path.Root("server_uuid"), req, resp)
+}
+
+// MARK: Helper functions
+
+func (r *serverLogDrainResource) updateLogDrain(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	uuid string,
+	body api.UpdateServerByUuidJSONRequestBody,
+) {
+	tflog.Debug(ctx, "Updating server log drain", map[string]interface{}{
+		"server_uuid": uuid,
+	})
+	updateResp, err := r.client.UpdateServerByUuidWithResponse(ctx, uuid, body)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error updating server log drain: server_uuid=%s", uuid),
+			err.Error(),
+		)
+		return
+	}
+
+	if updateResp.StatusCode() != http.StatusCreated {
+		util.AddAPIErrorAt(diags, fmt.Sprintf("updating server log drain: server_uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, serverLogDrainFieldPaths())
+		return
+	}
+}
+
+func (r *serverLogDrainResource) ReadFromAPI(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	uuid string,
+) serverLogDrainResourceModel {
+	readResp, err := r.client.GetServerByUuidWithResponse(ctx, uuid)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading server log drain: server_uuid=%s", uuid),
+			err.Error(),
+		)
+		return serverLogDrainResourceModel{}
+	}
+
+	if readResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, fmt.Sprintf("reading server log drain: server_uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
+		return serverLogDrainResourceModel{}
+	}
+
+	if readResp.JSON200.Settings == nil {
+		diags.AddError(
+			"Missing server settings",
+			fmt.Sprintf("No settings returned for server: server_uuid=%s", uuid))
+		return serverLogDrainResourceModel{}
+	}
+
+	return serverLogDrainResourceModel{}.FromAPI(uuid, readResp.JSON200.Settings)
+}
+
+func (m serverLogDrainResourceModel) FromAPI(serverUuid string, settings *api.ServerSetting) serverLogDrainResourceModel {
+	result := serverLogDrainResourceModel{
+		ServerUuid: types.StringValue(serverUuid),
+	}
+
+	if flatten.Bool(settings.IsLogdrainAxiomEnabled).ValueBool() {
+		result.Axiom = &serverLogDrainAxiomModel{
+			ApiKey:      flatten.String(settings.LogdrainAxiomApiKey),
+			DatasetName: flatten.String(settings.LogdrainAxiomDatasetName),
+		}
+	}
+	if flatten.Bool(settings.IsLogdrainNewrelicEnabled).ValueBool() {
+		result.NewRelic = &serverLogDrainNewRelicModel{
+			LicenseKey: flatten.String(settings.LogdrainNewrelicLicenseKey),
+			BaseUri:    flatten.String(settings.LogdrainNewrelicBaseUri),
+		}
+	}
+	if flatten.Bool(settings.IsLogdrainHighlightEnabled).ValueBool() {
+		result.Highlight = &serverLogDrainHighlightModel{
+			ProjectId: flatten.String(settings.LogdrainHighlightProjectId),
+		}
+	}
+	if flatten.Bool(settings.IsLogdrainCustomEnabled).ValueBool() {
+		result.Custom = &serverLogDrainCustomModel{
+			Config:       flatten.String(settings.LogdrainCustomConfig),
+			ConfigParser: flatten.String(settings.LogdrainCustomConfigParser),
+		}
+	}
+
+	return result
+}
+
+// serverLogDrainFieldPaths maps the Coolify fields set by toAPI to the attributes of the resource.
+func serverLogDrainFieldPaths() map[string]path.Path {
+	paths := map[string]path.Path{
+		"is_logdrain_axiom_enabled":     path.Root("axiom"),
+		"is_logdrain_newrelic_enabled":  path.Root("new_relic"),
+		"is_logdrain_highlight_enabled": path.Root("highlight"),
+		"is_logdrain_custom_enabled":    path.Root("custom"),
+	}
+	maps.Copy(paths, util.AttributePaths(serverLogDrainAxiomModel{}, "logdrain_axiom_", path.Root("axiom")))
+	maps.Copy(paths, util.AttributePaths(serverLogDrainNewRelicModel{}, "logdrain_newrelic_", path.Root("new_relic")))
+	maps.Copy(paths, util.AttributePaths(serverLogDrainHighlightModel{}, "logdrain_highlight_", path.Root("highlight")))
+	maps.Copy(paths, util.AttributePaths(serverLogDrainCustomModel{}, "logdrain_custom_", path.Root("custom")))
+	return paths
+}
+
+// toAPI enables the configured drain and disables all others.
+func (m serverLogDrainResourceModel) toAPI() api.UpdateServerByUuidJSONRequestBody {
+	body := api.UpdateServerByUuidJSONRequestBody{
+		IsLogdrainAxiomEnabled:     types.BoolValue(m.Axiom != nil).ValueBoolPointer(),
+		IsLogdrainNewrelicEnabled:  types.BoolValue(m.NewRelic != nil).ValueBoolPointer(),
+		IsLogdrainHighlightEnabled: types.BoolValue(m.Highlight != nil).ValueBoolPointer(),
+		IsLogdrainCustomEnabled:    types.BoolValue(m.Custom != nil).ValueBoolPointer(),
+	}
+
+	if m.Axiom != nil {
+		body.LogdrainAxiomApiKey = m.Axiom.ApiKey.ValueStringPointer()
+		body.LogdrainAxiomDatasetName = m.Axiom.DatasetName.ValueStringPointer()
+	}
+	if m.NewRelic != nil {
+		body.LogdrainNewrelicLicenseKey = m.NewRelic.LicenseKey.ValueStringPointer()
+		body.LogdrainNewrelicBaseUri = m.NewRelic.BaseUri.ValueStringPointer()
+	}
+	if m.Highlight != nil {
+		body.LogdrainHighlightProjectId = m.Highlight.ProjectId.ValueStringPointer()
+	}
+	if m.Custom != nil {
+		body.LogdrainCustomConfig = m.Custom.Config.ValueStringPointer()
+		body.LogdrainCustomConfigParser = expand.String(m.Custom.ConfigParser)
+	}
+
+	return body
+}
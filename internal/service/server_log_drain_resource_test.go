@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestAccServerLogDrainResource(t *testing.T) {
+	resName := "coolify_server_log_drain.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{ // Multiple drains are rejected at plan time
+				Config: `
+				resource "coolify_server_log_drain" "test" {
+					server_uuid = "` + acctest.ServerUUID + `"
+					highlight {
+						project_id = "tf-acc-project"
+					}
+					axiom {
+						api_key      = "xaat-tf-acc"
+						dataset_name = "tf-acc"
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{ // Create and Read testing
+				Config: `
+				resource "coolify_server_log_drain" "test" {
+					server_uuid = "` + acctest.ServerUUID + `"
+					highlight {
+						project_id = "tf-acc-project"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "server_uuid", acctest.ServerUUID),
+					resource.TestCheckResourceAttr(resName, "highlight.project_id", "tf-acc-project"),
+					resource.TestCheckNoResourceAttr(resName, "axiom.api_key"),
+				),
+			},
+			{ // ImportState testing
+				ResourceName:                         resName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        acctest.ServerUUID,
+				ImportStateVerifyIdentifierAttribute: "server_uuid",
+			},
+			{ // Switch drain kind
+				Config: `
+				resource "coolify_server_log_drain" "test" {
+					server_uuid = "` + acctest.ServerUUID + `"
+					axiom {
+						api_key      = "xaat-tf-acc"
+						dataset_name = "tf-acc"
+					}
+				}
+				`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "axiom.dataset_name", "tf-acc"),
+					resource.TestCheckNoResourceAttr(resName, "highlight.project_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestServerLogDrainResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	rs := service.NewServerLogDrainResource()
+	resp := &tfresource.SchemaResponse{}
+	rs.Schema(ctx, tfresource.SchemaRequest{}, resp)
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation failed: %v", diags)
+	}
+
+	for _, block := range []string{"axiom", "new_relic", "highlight", "custom"} {
+		if _, ok := resp.Schema.Blocks[block]; !ok {
+			t.Errorf("block %q should exist in schema", block)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var _ datasource.DataSource = &serverMetricsDataSource{}
+var _ datasource.DataSourceWithConfigure = &serverMetricsDataSource{}
+
+func NewServerMetricsDataSource() datasource.DataSource {
+	return &serverMetricsDataSource{}
+}
+
+type serverMetricsDataSource struct {
+	client *api.ClientWithResponses
+}
+
+type serverMetricsDataSourceModel struct {
+	ServerUuid         types.String  `tfsdk:"server_uuid"`
+	SentinelUrl        types.String  `tfsdk:"sentinel_url"`
+	WindowMinutes      types.Int64   `tfsdk:"window_minutes"`
+	CpuUsagePercent    types.Float64 `tfsdk:"cpu_usage_percent"`
+	MemoryUsagePercent types.Float64 `tfsdk:"memory_usage_percent"`
+	DiskUsagePercent   types.Float64 `tfsdk:"disk_usage_percent"`
+	CollectedAt        types.String  `tfsdk:"collected_at"`
+}
+
+func (d *serverMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_metrics"
+}
+
+func (d *serverMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the latest CPU, memory and disk usage of a Coolify server, as collected by its Sentinel agent." +
+			"\n**NOTE:** The Coolify API does not expose metrics, so they are read from the Sentinel agent directly." +
+			" The agent must be reachable at `sentinel_url`, and metrics must be enabled with `coolify_server_settings`." +
+			" A usage is null when the agent did not report a value for it.",
+		Attributes: map[string]schema.Attribute{
+			"server_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "UUID of the server.",
+			},
+			"sentinel_url": schema.StringAttribute{
+				Required:    true,
+				Description: "Base URL of the Sentinel agent running on the server, ie `http://10.0.0.2:8888`.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"window_minutes": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Only consider metrics collected in the last N minutes. Defaults to `%d`.",
+					consts.DEFAULT_SERVER_METRICS_WINDOW,
+				),
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"cpu_usage_percent": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Latest CPU usage in percent.",
+			},
+			"memory_usage_percent": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Latest memory usage in percent.",
+			},
+			"disk_usage_percent": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Latest usage of the root filesystem in percent. Null when the Sentinel agent does not collect disk metrics.",
+			},
+			"collected_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time the latest metrics were collected, in RFC3339 format. Null when no metrics are available.",
+			},
+		},
+	}
+}
+
+func (d *serverMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	util.ProviderDataFromDataSourceConfigureRequest(req, &d.client, resp)
+}
+
+func (d *serverMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan serverMetricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := plan.ServerUuid.ValueString()
+	response, err := d.client.GetServerByUuidWithResponse(ctx, uuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading server", err.Error(),
+		)
+		return
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		util.AddAPIError(&resp.Diagnostics, "reading server", response.HTTPResponse, response.Body, nil)
+		return
+	}
+
+	settings := response.JSON200.Settings
+	if settings == nil || settings.IsMetricsEnabled == nil || !*settings.IsMetricsEnabled {
+		resp.Diagnostics.AddError(
+			"Metrics are not enabled",
+			fmt.Sprintf("Metrics are not enabled for server: server_uuid=%s. Enable them with `is_metrics_enabled` in `coolify_server_settings`.", uuid),
+		)
+		return
+	}
+	if settings.SentinelToken == nil || *settings.SentinelToken == "" {
+		resp.Diagnostics.AddError(
+			"Missing Sentinel token",
+			fmt.Sprintf("No Sentinel token returned for server: server_uuid=%s", uuid),
+		)
+		return
+	}
+
+	window := int64(consts.DEFAULT_SERVER_METRICS_WINDOW)
+	if !plan.WindowMinutes.IsNull() {
+		window = plan.WindowMinutes.ValueInt64()
+	}
+	from := time.Now().Add(-time.Duration(window) * time.Minute)
+
+	tflog.Debug(ctx, "Reading server metrics", map[string]interface{}{
+		"server_uuid":  uuid,
+		"sentinel_url": plan.SentinelUrl.ValueString(),
+		"from":         from.UTC().Format(time.RFC3339),
+	})
+	sentinel := api.NewSentinelClient(plan.SentinelUrl.ValueString(), *settings.SentinelToken)
+
+	readServerMetrics(ctx, &resp.Diagnostics, sentinel, from, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// readServerMetrics sets the latest metrics collected since `from` on the model.
+func readServerMetrics(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	sentinel *api.SentinelClient,
+	from time.Time,
+	model *serverMetricsDataSourceModel,
+) {
+	cpu := latestMetric(ctx, diags, "CPU", from, sentinel.CPUHistory)
+	memory := latestMetric(ctx, diags, "memory", from, sentinel.MemoryHistory)
+	disk := latestMetric(ctx, diags, "disk", from, sentinel.DiskHistory)
+	if diags.HasError() {
+		return
+	}
+
+	var collectedAt time.Time
+	for _, metric := range []*api.SentinelMetric{cpu, memory, disk} {
+		if metric != nil && metric.Time.After(collectedAt) {
+			collectedAt = metric.Time
+		}
+	}
+
+	model.CpuUsagePercent = metricPercent(cpu)
+	model.MemoryUsagePercent = metricPercent(memory)
+	model.DiskUsagePercent = metricPercent(disk)
+	model.CollectedAt = types.StringNull()
+	if !collectedAt.IsZero() {
+		model.CollectedAt = types.StringValue(collectedAt.UTC().Format(time.RFC3339))
+	}
+}
+
+// latestMetric returns the most recent data point, or nil when the agent does not collect the metric.
+func latestMetric(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	name string,
+	from time.Time,
+	history func(context.Context, time.Time) ([]api.SentinelMetric, error),
+) *api.SentinelMetric {
+	metrics, err := history(ctx, from)
+	if errors.Is(err, api.ErrSentinelMetricUnavailable) {
+		diags.AddWarning(fmt.Sprintf("No %s metrics collected", name), err.Error())
+		return nil
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading %s metrics", name), err.Error())
+		return nil
+	}
+
+	if len(metrics) == 0 {
+		diags.AddError(
+			fmt.Sprintf("No %s metrics found", name),
+			fmt.Sprintf("The Sentinel agent returned no %s metrics since %s.", name, from.UTC().Format(time.RFC3339)),
+		)
+		return nil
+	}
+
+	latest := metrics[0]
+	for _, metric := range metrics[1:] {
+		if metric.Time.After(latest.Time) {
+			latest = metric
+		}
+	}
+	return &latest
+}
+
+func metricPercent(metric *api.SentinelMetric) types.Float64 {
+	if metric == nil {
+		return types.Float64Null()
+	}
+	return types.Float64PointerValue(metric.Percent)
+}
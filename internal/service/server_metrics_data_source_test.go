@@ -0,0 +1,27 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestServerMetricsDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := service.NewServerMetricsDataSource()
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation failed: %v", diags)
+	}
+
+	for _, attr := range []string{"server_uuid", "sentinel_url", "window_minutes", "cpu_usage_percent", "memory_usage_percent", "disk_usage_percent", "collected_at"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("expected attribute %q in schema", attr)
+		}
+	}
+}
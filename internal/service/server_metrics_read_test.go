@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestReadServerMetrics(t *testing.T) {
+	from := time.Now().Add(-5 * time.Minute)
+
+	t.Run("latest values", func(t *testing.T) {
+		sentinel := newMockSentinel(t, map[string]string{
+			"/api/cpu/history":    `[{"time": 1700000000000, "percent": 10}, {"time": 1700000060000, "percent": "25.5"}]`,
+			"/api/memory/history": `[{"time": 1700000030000, "usedPercent": 42.1}]`,
+			"/api/disk/history":   `[{"time": 1700000090000, "usedPercent": 80}]`,
+		})
+
+		var diags diag.Diagnostics
+		var model serverMetricsDataSourceModel
+		readServerMetrics(context.Background(), &diags, sentinel, from, &model)
+
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, types.Float64Value(25.5), model.CpuUsagePercent)
+		assert.Equal(t, types.Float64Value(42.1), model.MemoryUsagePercent)
+		assert.Equal(t, types.Float64Value(80), model.DiskUsagePercent)
+		assert.Equal(t, types.StringValue("2023-11-14T22:14:50Z"), model.CollectedAt)
+	})
+
+	t.Run("missing values are null", func(t *testing.T) {
+		sentinel := newMockSentinel(t, map[string]string{
+			"/api/cpu/history":    `[{"time": 1700000000000}]`,
+			"/api/memory/history": `[{"time": 1700000000000, "usedPercent": null}]`,
+		})
+
+		var diags diag.Diagnostics
+		var model serverMetricsDataSourceModel
+		readServerMetrics(context.Background(), &diags, sentinel, from, &model)
+
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, 1, diags.WarningsCount(), "expected a warning for the missing disk metrics")
+		assert.True(t, model.CpuUsagePercent.IsNull())
+		assert.True(t, model.MemoryUsagePercent.IsNull())
+		assert.True(t, model.DiskUsagePercent.IsNull())
+	})
+
+	t.Run("no metrics available", func(t *testing.T) {
+		sentinel := newMockSentinel(t, map[string]string{})
+
+		var diags diag.Diagnostics
+		var model serverMetricsDataSourceModel
+		readServerMetrics(context.Background(), &diags, sentinel, from, &model)
+
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, 3, diags.WarningsCount())
+		assert.True(t, model.CpuUsagePercent.IsNull())
+		assert.True(t, model.MemoryUsagePercent.IsNull())
+		assert.True(t, model.DiskUsagePercent.IsNull())
+		assert.True(t, model.CollectedAt.IsNull())
+	})
+
+	t.Run("no data points", func(t *testing.T) {
+		sentinel := newMockSentinel(t, map[string]string{
+			"/api/cpu/history":    `[]`,
+			"/api/memory/history": `[]`,
+			"/api/disk/history":   `[]`,
+		})
+
+		var diags diag.Diagnostics
+		var model serverMetricsDataSourceModel
+		readServerMetrics(context.Background(), &diags, sentinel, from, &model)
+
+		assert.True(t, diags.HasError())
+	})
+}
+
+// newMockSentinel serves the given bodies by path, and a 404 for any other path.
+func newMockSentinel(t *testing.T, bodies map[string]string) *api.SentinelClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return api.NewSentinelClient(server.URL, "token")
+}
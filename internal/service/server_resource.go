@@ -4,15 +4,25 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/expand"
 	"terraform-provider-coolify/internal/flatten"
 	"terraform-provider-coolify/internal/provider/generated/resource_server"
 	"terraform-provider-coolify/internal/provider/util"
@@ -22,6 +32,7 @@ var (
 	_ resource.Resource                = &serverResource{}
 	_ resource.ResourceWithConfigure   = &serverResource{}
 	_ resource.ResourceWithImportState = &serverResource{}
+	_ resource.ResourceWithIdentity    = &serverResource{}
 )
 
 func NewServerResource() resource.Resource {
@@ -32,6 +43,20 @@ type serverResource struct {
 	client *api.ClientWithResponses
 }
 
+type serverResourceModel struct {
+	resource_server.ServerModel
+	WaitForReady        types.Bool     `tfsdk:"wait_for_ready"`
+	ReadyTimeoutSeconds types.Int64    `tfsdk:"ready_timeout"`
+	IsReachable         types.Bool     `tfsdk:"is_reachable"`
+	IsUsable            types.Bool     `tfsdk:"is_usable"`
+	ProxyStatus         types.String   `tfsdk:"proxy_status"`
+	ForceDestroy        types.Bool     `tfsdk:"force_destroy"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// serverReadyPollInterval is the time between reachability checks while waiting for a server to become ready.
+var serverReadyPollInterval = 5 * time.Second
+
 func (r *serverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_server"
 }
@@ -50,6 +75,54 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	for _, attr := range validateNonEmptyStrings {
 		makeResourceAttributeNonEmpty(resp.Schema.Attributes, attr)
 	}
+
+	proxyType := resp.Schema.Attributes["proxy_type"].(schema.StringAttribute)
+	proxyType.Description = "The proxy to run on the server: `traefik`, `caddy` or `none`. Changing it switches the proxy of the server." +
+		" Starting, stopping and restarting the proxy, and its custom configuration, are not exposed by the Coolify API and can only be managed in the Coolify UI."
+	proxyType.MarkdownDescription = proxyType.Description
+	proxyType.PlanModifiers = append(proxyType.PlanModifiers, stringplanmodifier.UseStateForUnknown())
+	resp.Schema.Attributes["proxy_type"] = proxyType
+	resp.Schema.Attributes["proxy_status"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Status of the proxy as last reported by Coolify, ie `running` or `exited`. Null when Coolify does not report it.",
+	}
+
+	resp.Schema.Attributes["wait_for_ready"] = schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "Trigger server validation after the connection details change and wait until the server is reachable and usable. Default: `false`.",
+	}
+	resp.Schema.Attributes["ready_timeout"] = schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(consts.DEFAULT_SERVER_READY_TIMEOUT),
+		Validators:  []validator.Int64{int64validator.AtLeast(1)},
+		Description: fmt.Sprintf("Maximum time to wait for the server to become ready in seconds. Only used when `wait_for_ready` is enabled. Default: `%d`.", consts.DEFAULT_SERVER_READY_TIMEOUT),
+	}
+	resp.Schema.Attributes["force_destroy"] = schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Description: "Delete all applications, databases and services on the server, including their volumes, when the server is destroyed." +
+			" Without it, destroying a server that still hosts resources fails and lists them. Default: `false`.",
+	}
+	if resp.Schema.Blocks == nil {
+		resp.Schema.Blocks = map[string]schema.Block{}
+	}
+	resp.Schema.Blocks["timeouts"] = forceDestroyTimeoutsBlock(ctx)
+	resp.Schema.Attributes["is_reachable"] = schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether Coolify can reach the server over SSH.",
+	}
+	resp.Schema.Attributes["is_usable"] = schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the server passed validation and can host resources.",
+	}
+}
+
+func (r *serverResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("UUID of the server.")
 }
 
 func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,7 +130,7 @@ func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_server.ServerModel
+	var plan serverResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -81,6 +154,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 			return &value
 		}(),
 		PrivateKeyUuid: plan.PrivateKeyUuid.ValueStringPointer(),
+		ProxyType:      (*api.CreateServerJSONBodyProxyType)(expand.String(plan.ProxyType)),
 		User:           plan.User.ValueStringPointer(),
 	})
 
@@ -93,19 +167,22 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	if createResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating server",
-			fmt.Sprintf("Received %s creating server. Details: %s", createResp.Status(), createResp.Body),
-		)
+		util.AddAPIError(&resp.Diagnostics, "creating server", createResp.HTTPResponse, createResp.Body, plan)
 		return
 	}
 
-	data := r.ReadFromAPI(ctx, &resp.Diagnostics, *createResp.JSON201.Uuid)
+	uuid := *createResp.JSON201.Uuid
+	if plan.WaitForReady.ValueBool() {
+		r.waitForReady(ctx, &resp.Diagnostics, uuid, plan.ReadyTimeoutSeconds.ValueInt64())
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, uuid)
 	r.copyMissingAttributes(&plan, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentityModel{Uuid: data.Uuid})...)
 }
 func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_server.ServerModel
+	var state serverResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -121,13 +198,17 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, state.Uuid.ValueString())
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
 	r.copyMissingAttributes(&state, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentityModel{Uuid: data.Uuid})...)
 }
 
 func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan resource_server.ServerModel
-	var state resource_server.ServerModel
+	var plan serverResourceModel
+	var state serverResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -164,6 +245,13 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return &value
 		}(),
 		PrivateKeyUuid: plan.PrivateKeyUuid.ValueStringPointer(),
+		ProxyType: func() *api.UpdateServerByUuidJSONBodyProxyType {
+			// Coolify switches and restarts the proxy whenever a type is sent
+			if plan.ProxyType.Equal(state.ProxyType) {
+				return nil
+			}
+			return (*api.UpdateServerByUuidJSONBodyProxyType)(expand.String(plan.ProxyType))
+		}(),
 		User: func() *string {
 			if plan.User.IsUnknown() {
 				return nil
@@ -181,19 +269,27 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if updateResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code updating server",
-			fmt.Sprintf("Received %s updating server: uuid=%s. Details: %s", updateResp.Status(), uuid, updateResp.Body))
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("updating server: uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, plan)
 		return
 	}
 
+	// Only re-validate when the connection details changed or waiting was just enabled
+	connectionChanged := !(plan.Ip.Equal(state.Ip) &&
+		plan.Port.Equal(state.Port) &&
+		plan.User.Equal(state.User) &&
+		plan.PrivateKeyUuid.Equal(state.PrivateKeyUuid))
+	if plan.WaitForReady.ValueBool() && (connectionChanged || !state.WaitForReady.ValueBool()) {
+		r.waitForReady(ctx, &resp.Diagnostics, uuid, plan.ReadyTimeoutSeconds.ValueInt64())
+	}
+
 	data := r.ReadFromAPI(ctx, &resp.Diagnostics, uuid)
 	r.copyMissingAttributes(&plan, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentityModel{Uuid: data.Uuid})...)
 }
 
 func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_server.ServerModel
+	var state serverResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -202,38 +298,92 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	uuid := state.Uuid.ValueString()
+	blocking := serverBlockingResources(ctx, r.client, &resp.Diagnostics, uuid)
+	// Already deleted outside of Terraform
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(blocking) > 0 {
+		if !state.ForceDestroy.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Server still hosts resources",
+				fmt.Sprintf("Server %s (uuid=%s) cannot be deleted while it hosts the following resources:\n%s\n"+
+					"Delete them first, or set `force_destroy = true` to delete them together with the server.",
+					state.Name.ValueString(), uuid, formatBlockingResources(blocking)),
+			)
+			return
+		}
+
+		deleteBlockingResources(ctx, r.client, &resp.Diagnostics, blocking)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		timeout, diags := state.Timeouts.Delete(ctx, consts.DEFAULT_FORCE_DESTROY_TIMEOUT*time.Second)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		waitForNoBlockingResources(ctx, &resp.Diagnostics, timeout, func(diags *diag.Diagnostics) []blockingResource {
+			return serverBlockingResources(ctx, r.client, diags, uuid)
+		})
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Debug(ctx, "Deleting server", map[string]interface{}{
-		"uuid": state.Uuid.ValueString(),
+		"uuid": uuid,
 	})
-	deleteResp, err := r.client.DeleteServerByUuidWithResponse(ctx, state.Uuid.ValueString())
+	deleteResp, err := r.client.DeleteServerByUuidWithResponse(ctx, uuid)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete server, got error: %s", err))
 		return
 	}
 
-	if deleteResp.JSON200 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting server",
-			fmt.Sprintf("Received %s deleting server: %s. Details: %s", deleteResp.Status(), state, deleteResp.Body))
+	if deleteResp.JSON200 == nil && deleteResp.StatusCode() != http.StatusNotFound {
+		util.AddAPIError(&resp.Diagnostics, fmt.Sprintf("deleting server: uuid=%s", state.Uuid.ValueString()), deleteResp.HTTPResponse, deleteResp.Body, nil)
 		return
 	}
 }
 
 func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("uuid"), path.Root("uuid"), req, resp)
 }
 
 func (r *serverResource) copyMissingAttributes(
-	plan *resource_server.ServerModel,
-	data *resource_server.ServerModel,
+	plan *serverResourceModel,
+	data *serverResourceModel,
 ) {
 	// Values that are not returned in API response
 	data.InstantValidate = plan.InstantValidate
 	data.PrivateKeyUuid = plan.PrivateKeyUuid
+	data.WaitForReady = plan.WaitForReady
+	data.ReadyTimeoutSeconds = plan.ReadyTimeoutSeconds
+	data.ForceDestroy = plan.ForceDestroy
+	data.Timeouts = withForceDestroyTimeouts(plan.Timeouts)
 
 	if plan.PrivateKeyUuid.IsNull() {
 		data.PrivateKeyUuid = types.StringValue("")
 	}
+	if plan.WaitForReady.IsNull() {
+		data.WaitForReady = types.BoolValue(false)
+	}
+	if plan.ReadyTimeoutSeconds.IsNull() {
+		data.ReadyTimeoutSeconds = types.Int64Value(consts.DEFAULT_SERVER_READY_TIMEOUT)
+	}
+	if plan.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+	if data.ProxyType.IsNull() && !plan.ProxyType.IsUnknown() {
+		data.ProxyType = plan.ProxyType
+	}
 
 	// Values that are incorrectly mapped in API
 	data.Id = data.Settings.ServerId
@@ -243,31 +393,38 @@ func (r *serverResource) ReadFromAPI(
 	ctx context.Context,
 	diags *diag.Diagnostics,
 	uuid string,
-) resource_server.ServerModel {
+) serverResourceModel {
 	readResp, err := r.client.GetServerByUuidWithResponse(ctx, uuid)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error reading server: uuid=%s", uuid),
 			err.Error(),
 		)
-		return resource_server.ServerModel{}
+		return serverResourceModel{}
 	}
 
 	if readResp.StatusCode() != http.StatusOK {
-		diags.AddError(
-			"Unexpected HTTP status code reading server",
-			fmt.Sprintf("Received %s for server: uuid=%s. Details: %s", readResp.Status(), uuid, readResp.Body))
-		return resource_server.ServerModel{}
+		util.AddAPIError(diags, fmt.Sprintf("reading server: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
+		return serverResourceModel{}
+	}
+
+	data := r.ApiToModel(ctx, diags, readResp.JSON200)
+
+	// The `proxy` property is not decoded into the API model because its shape is unreliable
+	proxyType, proxyStatus := serverProxyFromBody(readResp.Body)
+	if data.ProxyType.IsNull() {
+		data.ProxyType = proxyType
 	}
+	data.ProxyStatus = proxyStatus
 
-	return r.ApiToModel(ctx, diags, readResp.JSON200)
+	return data
 }
 
 func (r *serverResource) ApiToModel(
 	ctx context.Context,
 	diags *diag.Diagnostics,
 	response *api.Server,
-) resource_server.ServerModel {
+) serverResourceModel {
 	settings := resource_server.NewSettingsValueMust(
 		resource_server.SettingsValue{}.AttributeTypes(ctx),
 		map[string]attr.Value{
@@ -310,26 +467,192 @@ func (r *serverResource) ApiToModel(
 		},
 	)
 
-	return resource_server.ServerModel{
-		Description:                   flatten.String(response.Description),
-		HighDiskUsageNotificationSent: flatten.Bool(response.HighDiskUsageNotificationSent), // missing
-		Id:                            flatten.Int64(response.Id),
-		Ip:                            flatten.String(response.Ip),
-		IsBuildServer:                 flatten.Bool(response.Settings.IsBuildServer),
-		LogDrainNotificationSent:      flatten.Bool(response.LogDrainNotificationSent),
-		Name:                          flatten.String(response.Name),
-		Port:                          flatten.Int64(response.Port),
-		SwarmCluster:                  flatten.String(response.SwarmCluster),
-		UnreachableCount:              flatten.Int64(response.UnreachableCount),
-		UnreachableNotificationSent:   flatten.Bool(response.UnreachableNotificationSent),
-		User:                          flatten.String(response.User),
-		Uuid:                          flatten.String(response.Uuid),
-		ValidationLogs:                flatten.String(response.ValidationLogs),
-
-		// Proxy:                         resource_server.NewProxyValueUnknown(),
-		ProxyType:       flatten.String((*string)(response.ProxyType)), // enum value
-		PrivateKeyUuid:  types.StringUnknown(),
-		InstantValidate: types.BoolUnknown(),
-		Settings:        settings,
+	return serverResourceModel{
+		ServerModel: resource_server.ServerModel{
+			Description:                   flatten.String(response.Description),
+			HighDiskUsageNotificationSent: flatten.Bool(response.HighDiskUsageNotificationSent), // missing
+			Id:                            flatten.Int64(response.Id),
+			Ip:                            flatten.String(response.Ip),
+			IsBuildServer:                 flatten.Bool(response.Settings.IsBuildServer),
+			LogDrainNotificationSent:      flatten.Bool(response.LogDrainNotificationSent),
+			Name:                          flatten.String(response.Name),
+			Port:                          flatten.Int64(response.Port),
+			SwarmCluster:                  flatten.String(response.SwarmCluster),
+			UnreachableCount:              flatten.Int64(response.UnreachableCount),
+			UnreachableNotificationSent:   flatten.Bool(response.UnreachableNotificationSent),
+			User:                          flatten.String(response.User),
+			Uuid:                          flatten.String(response.Uuid),
+			ValidationLogs:                flatten.String(response.ValidationLogs),
+
+			// Proxy:                         resource_server.NewProxyValueUnknown(),
+			ProxyType:       flatten.String((*string)(response.ProxyType)), // enum value
+			PrivateKeyUuid:  types.StringUnknown(),
+			InstantValidate: types.BoolUnknown(),
+			Settings:        settings,
+		},
+		IsReachable: flatten.Bool(response.Settings.IsReachable),
+		IsUsable:    flatten.Bool(response.Settings.IsUsable),
+		ProxyStatus: types.StringNull(),
+	}
+}
+
+// waitForReady triggers validation of the server and polls until it is reachable and usable, or the timeout expires.
+func (r *serverResource) waitForReady(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	uuid string,
+	timeoutSeconds int64,
+) {
+	validation, ok := ValidateServer(ctx, r.client, diags, uuid)
+	if !ok {
+		return
+	}
+
+	WaitForServerReady(ctx, r.client, diags, uuid, validation, timeoutSeconds, serverReadyPollInterval, func(message string) {
+		tflog.Debug(ctx, message)
+	})
+}
+
+// ServerValidation is a validation of a server triggered by ValidateServer.
+type ServerValidation struct {
+	// Message is the message returned by Coolify when the validation was triggered.
+	Message string
+
+	// The settings timestamp and validation logs before the validation was triggered.
+	// Coolify updates either of them once the validation completed.
+	settingsUpdatedAt string
+	validationLogs    string
+}
+
+// completedBy reports whether the server read shows the outcome of the validation,
+// rather than the readiness left over by a previous one.
+func (v ServerValidation) completedBy(server *api.Server) bool {
+	return flatten.String(server.Settings.UpdatedAt).ValueString() != v.settingsUpdatedAt ||
+		flatten.String(server.ValidationLogs).ValueString() != v.validationLogs
+}
+
+// ValidateServer triggers validation of the server and returns it, along with the message returned by Coolify.
+// Coolify validates the server asynchronously, so callers wait for the outcome with WaitForServerReady.
+func ValidateServer(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	uuid string,
+) (ServerValidation, bool) {
+	readResp, err := client.GetServerByUuidWithResponse(ctx, uuid)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading server: uuid=%s", uuid),
+			err.Error(),
+		)
+		return ServerValidation{}, false
+	}
+
+	if readResp.StatusCode() != http.StatusOK || readResp.JSON200 == nil || readResp.JSON200.Settings == nil {
+		util.AddAPIError(diags, fmt.Sprintf("reading server: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
+		return ServerValidation{}, false
+	}
+
+	validation := ServerValidation{
+		settingsUpdatedAt: flatten.String(readResp.JSON200.Settings.UpdatedAt).ValueString(),
+		validationLogs:    flatten.String(readResp.JSON200.ValidationLogs).ValueString(),
+	}
+
+	tflog.Debug(ctx, "Validating server", map[string]interface{}{
+		"uuid": uuid,
+	})
+	validateResp, err := client.ValidateServerByUuidWithResponse(ctx, uuid)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error validating server: uuid=%s", uuid),
+			err.Error(),
+		)
+		return ServerValidation{}, false
+	}
+
+	if validateResp.StatusCode() != http.StatusCreated {
+		util.AddAPIError(diags, fmt.Sprintf("validating server: uuid=%s", uuid), validateResp.HTTPResponse, validateResp.Body, nil)
+		return ServerValidation{}, false
+	}
+
+	validation.Message = messageFromBody(validateResp.Body)
+	return validation, true
+}
+
+// WaitForServerReady polls every pollInterval until the validation completed and the server is reachable and usable,
+// or the timeout expires. Changes of reachability are reported through progress.
+// Until the validation completed, the server still reports the readiness of the previous one, which is ignored.
+func WaitForServerReady(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	diags *diag.Diagnostics,
+	uuid string,
+	validation ServerValidation,
+	timeoutSeconds int64,
+	pollInterval time.Duration,
+	progress func(message string),
+) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var completed bool
+	var validationLogs, readiness, lastErr string
+	for {
+		tflog.Debug(ctx, "Waiting for server to become ready", map[string]interface{}{
+			"uuid": uuid,
+		})
+
+		select {
+		case <-ctx.Done():
+			if validationLogs == "" {
+				validationLogs = "No validation logs returned by Coolify."
+			}
+			detail := fmt.Sprintf("The server was not reachable and usable within %d seconds. Validation logs: %s", timeoutSeconds, validationLogs)
+			if !completed {
+				detail = fmt.Sprintf("Coolify did not report the outcome of the validation within %d seconds.", timeoutSeconds)
+			}
+			if validation.Message != "" {
+				detail += "\nMessage returned when validation was triggered: " + validation.Message
+			}
+			if lastErr != "" {
+				detail += "\nLast error reading the server: " + lastErr
+			}
+			diags.AddError(fmt.Sprintf("Server did not become ready: uuid=%s", uuid), detail)
+			return
+		case <-ticker.C:
+		}
+
+		readResp, err := client.GetServerByUuidWithResponse(ctx, uuid)
+		switch {
+		case err != nil:
+			if ctx.Err() == nil {
+				lastErr = err.Error()
+			}
+			continue
+		case readResp.StatusCode() != http.StatusOK || readResp.JSON200 == nil || readResp.JSON200.Settings == nil:
+			lastErr = api.DecodeError(readResp.HTTPResponse, readResp.Body).Error()
+			continue
+		}
+		lastErr = ""
+
+		if !completed && !validation.completedBy(readResp.JSON200) {
+			continue
+		}
+		completed = true
+
+		settings := readResp.JSON200.Settings
+		isReachable := flatten.Bool(settings.IsReachable).ValueBool()
+		isUsable := flatten.Bool(settings.IsUsable).ValueBool()
+		if current := fmt.Sprintf("Server %s: reachable=%t, usable=%t", uuid, isReachable, isUsable); current != readiness {
+			readiness = current
+			progress(readiness)
+		}
+		if isReachable && isUsable {
+			return
+		}
+		validationLogs = flatten.String(readResp.JSON200.ValidationLogs).ValueString()
 	}
 }
@@ -62,6 +62,7 @@ func TestAccServerResource(t *testing.T) {
 					port = 22
 					private_key_uuid = "` + acctest.PrivateKeyUUID + `"
 					instant_validate = false
+					proxy_type = "caddy"
 				}
 				`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -77,6 +78,7 @@ func TestAccServerResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resName, "uuid"),
 					resource.TestCheckResourceAttr(resName, "name", "TerraformAccTestUpdated"),
+					resource.TestCheckResourceAttr(resName, "proxy_type", "caddy"),
 					resource.TestCheckResourceAttr(resName, "description", "Terraform acceptance testing"),
 					resource.TestCheckResourceAttr(resName, "private_key_uuid", acctest.PrivateKeyUUID),
 				),
@@ -90,4 +92,10 @@ func TestServerResourceSchema(t *testing.T) {
 	rs := service.NewServerResource()
 	resp := &tfresource.SchemaResponse{}
 	rs.Schema(ctx, tfresource.SchemaRequest{}, resp)
+
+	for _, attr := range []string{"wait_for_ready", "ready_timeout", "is_reachable", "is_usable", "proxy_type", "proxy_status", "force_destroy"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("attribute %q should exist in schema", attr)
+		}
+	}
 }
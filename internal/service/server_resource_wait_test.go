@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-coolify/internal/api"
+)
+
+func TestWaitForServerReady(t *testing.T) {
+	pollInterval := 10 * time.Millisecond
+
+	newClient := func(t *testing.T, handler http.HandlerFunc) *api.ClientWithResponses {
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+		require.NoError(t, err)
+		return client
+	}
+	serverBody := func(updatedAt string, reachable, usable bool) string {
+		return fmt.Sprintf(`{"uuid": "s1", "validation_logs": "Server is not reachable.", "settings": {"updated_at": %q, "is_reachable": %t, "is_usable": %t}}`, updatedAt, reachable, usable)
+	}
+	validation := ServerValidation{Message: "Validation started.", settingsUpdatedAt: "t0", validationLogs: "Server is not reachable."}
+
+	t.Run("becomes ready", func(t *testing.T) {
+		var polls atomic.Int32
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/servers/s1", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			switch poll := polls.Add(1); poll {
+			case 1:
+				// Readiness of the previous validation
+				fmt.Fprint(w, serverBody("t0", true, true))
+			default:
+				fmt.Fprint(w, serverBody("t1", poll >= 3, poll >= 4))
+			}
+		})
+
+		var diags diag.Diagnostics
+		var messages []string
+		WaitForServerReady(context.Background(), client, &diags, "s1", validation, 5, pollInterval, func(message string) { messages = append(messages, message) })
+
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, int32(4), polls.Load())
+		assert.Equal(t, []string{
+			"Server s1: reachable=false, usable=false",
+			"Server s1: reachable=true, usable=false",
+			"Server s1: reachable=true, usable=true",
+		}, messages)
+	})
+
+	t.Run("changed validation logs complete the validation", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"uuid": "s1", "validation_logs": "", "settings": {"updated_at": "t0", "is_reachable": true, "is_usable": true}}`)
+		})
+
+		var diags diag.Diagnostics
+		WaitForServerReady(context.Background(), client, &diags, "s1", validation, 5, pollInterval, func(string) {})
+
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("ignores readiness of the previous validation", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, serverBody("t0", true, true))
+		})
+
+		var diags diag.Diagnostics
+		WaitForServerReady(context.Background(), client, &diags, "s1", validation, 1, pollInterval, func(string) {})
+
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "Coolify did not report the outcome of the validation")
+		assert.Contains(t, diags.Errors()[0].Detail(), "Validation started.")
+	})
+
+	t.Run("timeout reports validation logs", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, serverBody("t1", false, false))
+		})
+
+		var diags diag.Diagnostics
+		WaitForServerReady(context.Background(), client, &diags, "s1", validation, 1, pollInterval, func(string) {})
+
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "Server is not reachable.")
+		assert.Contains(t, diags.Errors()[0].Detail(), "Validation started.")
+	})
+
+	t.Run("timeout reports last poll error", func(t *testing.T) {
+		client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "You are not allowed to access this server."}`)
+		})
+
+		var diags diag.Diagnostics
+		WaitForServerReady(context.Background(), client, &diags, "s1", validation, 1, pollInterval, func(string) {})
+
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "Last error reading the server")
+		assert.Contains(t, diags.Errors()[0].Detail(), "You are not allowed to access this server.")
+	})
+}
+
+func TestValidateServer(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/servers/s1":
+			fmt.Fprint(w, `{"uuid": "s1", "validation_logs": "Server is not reachable.", "settings": {"updated_at": "t0"}}`)
+		case "/servers/s1/validate":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"message": "Validation started."}`)
+		}
+	}))
+	defer server.Close()
+	client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+	require.NoError(t, err)
+
+	var diags diag.Diagnostics
+	validation, ok := ValidateServer(context.Background(), client, &diags, "s1")
+
+	require.True(t, ok, diags)
+	assert.Equal(t, []string{"/servers/s1", "/servers/s1/validate"}, requests)
+	assert.Equal(t, ServerValidation{Message: "Validation started.", settingsUpdatedAt: "t0", validationLogs: "Server is not reachable."}, validation)
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -59,10 +58,7 @@ func (d *serverResourcesDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	if response.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading server resources",
-			fmt.Sprintf("Received %s for server resources. Details: %s", response.Status(), string(response.Body)),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading server resources", response.HTTPResponse, response.Body, nil)
 		return
 	}
 
@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/expand"
+	"terraform-provider-coolify/internal/flatten"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ resource.Resource                   = &serverSettingsResource{}
+	_ resource.ResourceWithConfigure      = &serverSettingsResource{}
+	_ resource.ResourceWithImportState    = &serverSettingsResource{}
+	_ resource.ResourceWithIdentity       = &serverSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &serverSettingsResource{}
+)
+
+func NewServerSettingsResource() resource.Resource {
+	return &serverSettingsResource{}
+}
+
+type serverSettingsResource struct {
+	client *api.ClientWithResponses
+}
+
+type serverSettingsResourceModel struct {
+	ServerUuid             types.String `tfsdk:"server_uuid"`
+	ConcurrentBuilds       types.Int64  `tfsdk:"concurrent_builds"`
+	DynamicTimeout         types.Int64  `tfsdk:"dynamic_timeout"`
+	DockerCleanupFrequency types.String `tfsdk:"docker_cleanup_frequency"`
+	DockerCleanupThreshold types.Int64  `tfsdk:"docker_cleanup_threshold"`
+	DeleteUnusedVolumes    types.Bool   `tfsdk:"delete_unused_volumes"`
+	DeleteUnusedNetworks   types.Bool   `tfsdk:"delete_unused_networks"`
+	ForceServerCleanup     types.Bool   `tfsdk:"force_server_cleanup"`
+	WildcardDomain         types.String `tfsdk:"wildcard_domain"`
+
+	IsSentinelEnabled                 types.Bool   `tfsdk:"is_sentinel_enabled"`
+	IsMetricsEnabled                  types.Bool   `tfsdk:"is_metrics_enabled"`
+	SentinelMetricsHistoryDays        types.Int64  `tfsdk:"sentinel_metrics_history_days"`
+	SentinelMetricsRefreshRateSeconds types.Int64  `tfsdk:"sentinel_metrics_refresh_rate_seconds"`
+	SentinelToken                     types.String `tfsdk:"sentinel_token"`
+
+	IsSwarmManager types.Bool `tfsdk:"is_swarm_manager"`
+	IsSwarmWorker  types.Bool `tfsdk:"is_swarm_worker"`
+}
+
+func (r *serverSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_settings"
+}
+
+func (r *serverSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the settings of a Coolify server, including docker cleanup, builds, swarm roles and the Sentinel monitoring agent." +
+			"\n**NOTE:** Settings cannot be removed from a server. Destroying this resource only removes it from the Terraform state." +
+			" Attributes that are not configured keep their current value on the server.",
+		Attributes: map[string]schema.Attribute{
+			"server_uuid": schema.StringAttribute{
+				Required:      true,
+				Description:   "UUID of the server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"concurrent_builds": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Number of builds that can run concurrently on the server.",
+				Validators:    []validator.Int64{int64validator.AtLeast(1)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"dynamic_timeout": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Deployment timeout in seconds.",
+				Validators:    []validator.Int64{int64validator.AtLeast(1)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"docker_cleanup_frequency": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Docker cleanup frequency as a cron expression, ie `0 0 * * *`.",
+				Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"docker_cleanup_threshold": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Disk usage percentage that triggers a docker cleanup.",
+				Validators:    []validator.Int64{int64validator.Between(1, 100)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"delete_unused_volumes": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Delete unused volumes during docker cleanup.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"delete_unused_networks": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Delete unused networks during docker cleanup.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"force_server_cleanup": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Run docker cleanup on schedule regardless of the disk usage threshold.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"wildcard_domain": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Wildcard domain used to generate domains for resources on the server, ie `https://example.com`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"is_sentinel_enabled": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Run the Sentinel agent on the server.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"is_metrics_enabled": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Collect CPU and memory metrics with the Sentinel agent. Requires `is_sentinel_enabled`.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"sentinel_metrics_history_days": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Number of days metrics are kept by the Sentinel agent.",
+				Validators:    []validator.Int64{int64validator.AtLeast(1)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"sentinel_metrics_refresh_rate_seconds": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Interval between metric collections in seconds.",
+				Validators:    []validator.Int64{int64validator.AtLeast(1)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"sentinel_token": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "Token used to authenticate against the Sentinel agent.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"is_swarm_manager": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Use the server as a Docker Swarm manager. Applications deployed to a swarm manager can use `coolify_application_swarm`.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"is_swarm_worker": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Use the server as a Docker Swarm worker.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *serverSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serverSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IsSwarmManager.ValueBool() && config.IsSwarmWorker.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("is_swarm_worker"),
+			"Conflicting swarm roles",
+			"A server cannot be both a swarm manager and a swarm worker.",
+		)
+	}
+}
+
+func (r *serverSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("server_uuid", "UUID of the server.")
+}
+
+func (r *serverSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
+}
+
+func (r *serverSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateSettings(ctx, &resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, plan.ServerUuid.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverIdentityModel{ServerUuid: data.ServerUuid})...)
+}
+
+func (r *serverSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading server settings", map[string]interface{}{
+		"server_uuid": state.ServerUuid.ValueString(),
+	})
+	if state.ServerUuid.ValueString() == "" {
+		resp.Diagnostics.AddError("Invalid State", "No server UUID found in state")
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, state.ServerUuid.ValueString())
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverIdentityModel{ServerUuid: data.ServerUuid})...)
+}
+
+func (r *serverSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateSettings(ctx, &resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := r.ReadFromAPI(ctx, &resp.Diagnostics, plan.ServerUuid.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverIdentityModel{ServerUuid: data.ServerUuid})...)
+}
+
+func (r *serverSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings are part of the server and cannot be deleted
+	tflog.Debug(ctx, "Removing server settings from state", map[string]interface{}{
+		"server_uuid": state.ServerUuid.ValueString(),
+	})
+}
+
+func (r *serverSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("server_uuid"), path.Root("server_uuid"), req, resp)
+}
+
+// MARK: Helper functions
+
+func (r *serverSettingsResource) updateSettings(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	plan serverSettingsResourceModel,
+) {
+	uuid := plan.ServerUuid.ValueString()
+
+	tflog.Debug(ctx, "Updating server settings", map[string]interface{}{
+		"server_uuid": uuid,
+	})
+	updateResp, err := r.client.UpdateServerByUuidWithResponse(ctx, uuid, api.UpdateServerByUuidJSONRequestBody{
+		ConcurrentBuilds:       expand.Int64(plan.ConcurrentBuilds),
+		DynamicTimeout:         expand.Int64(plan.DynamicTimeout),
+		DockerCleanupFrequency: expand.String(plan.DockerCleanupFrequency),
+		DockerCleanupThreshold: expand.Int64(plan.DockerCleanupThreshold),
+		DeleteUnusedVolumes:    expand.Bool(plan.DeleteUnusedVolumes),
+		DeleteUnusedNetworks:   expand.Bool(plan.DeleteUnusedNetworks),
+		ForceServerCleanup:     expand.Bool(plan.ForceServerCleanup),
+		WildcardDomain:         expand.String(plan.WildcardDomain),
+
+		IsSentinelEnabled:                 expand.Bool(plan.IsSentinelEnabled),
+		IsMetricsEnabled:                  expand.Bool(plan.IsMetricsEnabled),
+		SentinelMetricsHistoryDays:        expand.Int64(plan.SentinelMetricsHistoryDays),
+		SentinelMetricsRefreshRateSeconds: expand.Int64(plan.SentinelMetricsRefreshRateSeconds),
+
+		IsSwarmManager: expand.Bool(plan.IsSwarmManager),
+		IsSwarmWorker:  expand.Bool(plan.IsSwarmWorker),
+	})
+
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error updating server settings: server_uuid=%s", uuid),
+			err.Error(),
+		)
+		return
+	}
+
+	if updateResp.StatusCode() != http.StatusCreated {
+		util.AddAPIError(diags, fmt.Sprintf("updating server settings: server_uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, plan)
+		return
+	}
+}
+
+func (r *serverSettingsResource) ReadFromAPI(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	uuid string,
+) serverSettingsResourceModel {
+	readResp, err := r.client.GetServerByUuidWithResponse(ctx, uuid)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading server settings: server_uuid=%s", uuid),
+			err.Error(),
+		)
+		return serverSettingsResourceModel{}
+	}
+
+	if readResp.StatusCode() != http.StatusOK {
+		util.AddAPIError(diags, fmt.Sprintf("reading server settings: server_uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
+		return serverSettingsResourceModel{}
+	}
+
+	if readResp.JSON200.Settings == nil {
+		diags.AddError(
+			"Missing server settings",
+			fmt.Sprintf("No settings returned for server: server_uuid=%s", uuid))
+		return serverSettingsResourceModel{}
+	}
+
+	return serverSettingsResourceModel{}.FromAPI(uuid, readResp.JSON200.Settings)
+}
+
+func (m serverSettingsResourceModel) FromAPI(serverUuid string, settings *api.ServerSetting) serverSettingsResourceModel {
+	return serverSettingsResourceModel{
+		ServerUuid:             types.StringValue(serverUuid),
+		ConcurrentBuilds:       flatten.Int64(settings.ConcurrentBuilds),
+		DynamicTimeout:         flatten.Int64(settings.DynamicTimeout),
+		DockerCleanupFrequency: flatten.String(settings.DockerCleanupFrequency),
+		DockerCleanupThreshold: flatten.Int64(settings.DockerCleanupThreshold),
+		DeleteUnusedVolumes:    flatten.Bool(settings.DeleteUnusedVolumes),
+		DeleteUnusedNetworks:   flatten.Bool(settings.DeleteUnusedNetworks),
+		ForceServerCleanup:     flatten.Bool(settings.ForceServerCleanup),
+		WildcardDomain:         flatten.String(settings.WildcardDomain),
+
+		IsSentinelEnabled:                 flatten.Bool(settings.IsSentinelEnabled),
+		IsMetricsEnabled:                  flatten.Bool(settings.IsMetricsEnabled),
+		SentinelMetricsHistoryDays:        flatten.Int64(settings.SentinelMetricsHistoryDays),
+		SentinelMetricsRefreshRateSeconds: flatten.Int64(settings.SentinelMetricsRefreshRateSeconds),
+		SentinelToken:                     flatten.String(settings.SentinelToken),
+
+		IsSwarmManager: flatten.Bool(settings.IsSwarmManager),
+		IsSwarmWorker:  flatten.Bool(settings.IsSwarmWorker),
+	}
+}
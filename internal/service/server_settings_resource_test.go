@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestAccServerSettingsResource(t *testing.T) {
+	resName := "coolify_server_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				Config: `
+				resource "coolify_server_settings" "test" {
+					server_uuid              = "` + acctest.ServerUUID + `"
+					concurrent_builds        = 2
+					docker_cleanup_threshold = 80
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "server_uuid", acctest.ServerUUID),
+					resource.TestCheckResourceAttr(resName, "concurrent_builds", "2"),
+					resource.TestCheckResourceAttr(resName, "docker_cleanup_threshold", "80"),
+					// Verify values read from the server
+					resource.TestCheckResourceAttrSet(resName, "dynamic_timeout"),
+					resource.TestCheckResourceAttrSet(resName, "docker_cleanup_frequency"),
+					resource.TestCheckResourceAttrSet(resName, "is_sentinel_enabled"),
+					resource.TestCheckResourceAttrSet(resName, "sentinel_metrics_history_days"),
+				),
+			},
+			{ // ImportState testing
+				ResourceName:                         resName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        acctest.ServerUUID,
+				ImportStateVerifyIdentifierAttribute: "server_uuid",
+			},
+			{ // Update and Read testing
+				Config: `
+				resource "coolify_server_settings" "test" {
+					server_uuid              = "` + acctest.ServerUUID + `"
+					concurrent_builds        = 3
+					docker_cleanup_threshold = 80
+				}
+				`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resName, tfjsonpath.New("concurrent_builds"), knownvalue.Int64Exact(3)),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "concurrent_builds", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServerSettingsResourceSwarmRoles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "coolify_server_settings" "test" {
+					server_uuid      = "` + acctest.ServerUUID + `"
+					is_swarm_manager = true
+					is_swarm_worker  = true
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Conflicting swarm roles`),
+			},
+		},
+	})
+}
+
+func TestServerSettingsResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	rs := service.NewServerSettingsResource()
+	resp := &tfresource.SchemaResponse{}
+	rs.Schema(ctx, tfresource.SchemaRequest{}, resp)
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation failed: %v", diags)
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/consts"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var (
+	_ action.Action              = &serverValidateAction{}
+	_ action.ActionWithConfigure = &serverValidateAction{}
+)
+
+type serverValidateAction struct {
+	client *api.ClientWithResponses
+}
+
+type serverValidateActionModel struct {
+	Uuid    types.String `tfsdk:"uuid"`
+	Wait    types.Bool   `tfsdk:"wait"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func NewServerValidateAction() action.Action {
+	return &serverValidateAction{}
+}
+
+func (a *serverValidateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_validate"
+}
+
+func (a *serverValidateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Validates the connection to a Coolify server.",
+		MarkdownDescription: "Validates the connection to a Coolify server. Progress is reported until the server is reachable and usable, unless `wait` is disabled.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "UUID of the server.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait until the server is reachable and usable. Default: `true`.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+				Description: fmt.Sprintf("Maximum time to wait in seconds. Default: `%d`.", consts.DEFAULT_SERVER_READY_TIMEOUT),
+			},
+		},
+	}
+}
+
+func (a *serverValidateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	util.ProviderDataFromActionConfigureRequest(req, &a.client, resp)
+}
+
+func (a *serverValidateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config serverValidateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	progress := actionProgress(ctx, resp)
+	uuid := config.Uuid.ValueString()
+
+	progress(fmt.Sprintf("Validating server %s", uuid))
+	validation, ok := ValidateServer(ctx, a.client, &resp.Diagnostics, uuid)
+	if !ok {
+		return
+	}
+	if validation.Message != "" {
+		progress(validation.Message)
+	}
+
+	if !config.Wait.IsNull() && !config.Wait.ValueBool() {
+		return
+	}
+
+	timeout := int64(consts.DEFAULT_SERVER_READY_TIMEOUT)
+	if !config.Timeout.IsNull() {
+		timeout = config.Timeout.ValueInt64()
+	}
+	WaitForServerReady(ctx, a.client, &resp.Diagnostics, uuid, validation, timeout, serverReadyPollInterval, progress)
+}
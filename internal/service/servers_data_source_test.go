@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -45,6 +46,75 @@ func TestAccServersDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "servers.0.ip", "host.docker.internal"),
 				),
 			},
+			// Filter with a regex
+			{
+				Config: `
+				data "coolify_servers" "test" {
+					filter {
+						name   = "ip"
+						match  = "regex"
+						values = ["^host\\.docker\\."]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(resName, "servers.0.uuid", acctest.ServerUUID),
+				),
+			},
+			// Filter on a nested attribute
+			{
+				Config: `
+				data "coolify_servers" "test" {
+					filter {
+						name   = "settings.is_reachable"
+						values = ["true"]
+					}
+					filter {
+						name   = "uuid"
+						values = ["` + acctest.ServerUUID + `"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(resName, "servers.0.settings.is_reachable", "true"),
+				),
+			},
+			// Collections and unknown names are rejected at plan time
+			{
+				Config: `
+				data "coolify_servers" "test" {
+					filter {
+						name   = "settings"
+						values = ["true"]
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			// Sorted and limited
+			{
+				Config: `
+				data "coolify_servers" "test" {
+					sort_by = "id"
+					order   = "asc"
+					limit   = 1
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(resName, "servers.0.id", "0"),
+				),
+			},
+			// Invalid regex is rejected at plan time
+			{
+				Config: `
+				data "coolify_servers" "test" {
+					filter {
+						name   = "ip"
+						match  = "regex"
+						values = ["host("]
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
 		},
 	})
 }
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -63,10 +62,7 @@ func (d *serviceDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	if serviceResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading service",
-			fmt.Sprintf("Received %s for service. Details: %s", serviceResp.Status(), string(serviceResp.Body)),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading service", serviceResp.HTTPResponse, serviceResp.Body, nil)
 		return
 	}
 
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -25,6 +26,7 @@ var (
 	_ resource.Resource                = &serviceEnvsResource{}
 	_ resource.ResourceWithConfigure   = &serviceEnvsResource{}
 	_ resource.ResourceWithImportState = &serviceEnvsResource{}
+	_ resource.ResourceWithIdentity    = &serviceEnvsResource{}
 )
 
 func NewServiceEnvsResource() resource.Resource {
@@ -93,6 +95,10 @@ func (r *serviceEnvsResource) Schema(ctx context.Context, req resource.SchemaReq
 	makeResourceAttributeRequired(codegenSchema.Attributes, "value")
 }
 
+func (r *serviceEnvsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("service_uuid", "UUID of the service.")
+}
+
 func (r *serviceEnvsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	util.ProviderDataFromResourceConfigureRequest(req, &r.client, resp)
 }
@@ -128,10 +134,8 @@ func (r *serviceEnvsResource) Create(ctx context.Context, req resource.CreateReq
 		}
 
 		if createResp.StatusCode() != http.StatusCreated {
-			resp.Diagnostics.AddError(
-				"Unexpected HTTP status code creating service envs",
-				fmt.Sprintf("Received %s creating service envs. Details: %s", createResp.Status(), createResp.Body),
-			)
+			util.AddAPIErrorAt(&resp.Diagnostics, "creating service envs", createResp.HTTPResponse, createResp.Body,
+				util.AttributePaths(env, "", path.Root("env").AtListIndex(i)))
 			return
 		}
 
@@ -143,6 +147,7 @@ func (r *serviceEnvsResource) Create(ctx context.Context, req resource.CreateReq
 	data.Env = r.filterRelevantEnvs(plan.Env, data.Env)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serviceIdentityModel{ServiceUuid: data.Uuid})...)
 }
 
 func (r *serviceEnvsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -162,10 +167,14 @@ func (r *serviceEnvsResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	data := r.readFromAPI(ctx, &resp.Diagnostics, state.Uuid.ValueString())
+	if util.RemoveResourceIfNotFound(ctx, &resp.Diagnostics, &resp.State) {
+		return
+	}
 	if len(state.Env) > 0 {
 		data.Env = r.filterRelevantEnvs(state.Env, data.Env)
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serviceIdentityModel{ServiceUuid: data.Uuid})...)
 }
 
 func (r *serviceEnvsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -218,7 +227,10 @@ func (r *serviceEnvsResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	var bulkUpdateEnvs = []updateEnvsByServiceUuidJSONRequestBodyItem{}
-	for _, env := range plan.Env {
+	// Validation errors of the bulk update are reported per item, ie `data.0.key`
+	errorPaths := map[string]path.Path{}
+	for i, env := range plan.Env {
+		maps.Copy(errorPaths, util.AttributePaths(env, fmt.Sprintf("data.%d.", i), path.Root("env").AtListIndex(i)))
 		bulkUpdateEnvs = append(bulkUpdateEnvs, updateEnvsByServiceUuidJSONRequestBodyItem{
 			IsBuildTime: env.IsBuildTime.ValueBoolPointer(),
 			IsLiteral:   env.IsLiteral.ValueBoolPointer(),
@@ -244,9 +256,7 @@ func (r *serviceEnvsResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 
 		if updateResp.StatusCode() != http.StatusCreated {
-			resp.Diagnostics.AddError(
-				"Unexpected HTTP status code updating service envs",
-				fmt.Sprintf("Received %s updating service envs: uuid=%s. Details: %s", updateResp.Status(), uuid, updateResp.Body))
+			util.AddAPIErrorAt(&resp.Diagnostics, fmt.Sprintf("updating service envs: uuid=%s", uuid), updateResp.HTTPResponse, updateResp.Body, errorPaths)
 			return
 		}
 	}
@@ -254,6 +264,7 @@ func (r *serviceEnvsResource) Update(ctx context.Context, req resource.UpdateReq
 	data := r.readFromAPI(ctx, &resp.Diagnostics, uuid)
 	data.Env = r.filterRelevantEnvs(plan.Env, data.Env)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serviceIdentityModel{ServiceUuid: data.Uuid})...)
 }
 
 func (r *serviceEnvsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -275,7 +286,7 @@ func (r *serviceEnvsResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *serviceEnvsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("uuid"), path.Root("service_uuid"), req, resp)
 }
 
 // MARK: Helper Functions
@@ -328,9 +339,7 @@ func (r *serviceEnvsResource) readFromAPI(
 	}
 
 	if readResp.StatusCode() != http.StatusOK {
-		diags.AddError(
-			"Unexpected HTTP status code reading service envs",
-			fmt.Sprintf("Received %s for service envs: uuid=%s. Details: %s", readResp.Status(), uuid, readResp.Body))
+		util.AddAPIError(diags, fmt.Sprintf("reading service envs: uuid=%s", uuid), readResp.HTTPResponse, readResp.Body, nil)
 		return serviceEnvsResourceModel{}
 	}
 
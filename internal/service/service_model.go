@@ -0,0 +1,159 @@
+package service
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/flatten"
+)
+
+type serviceModel struct {
+	Applications                    []serviceApplicationModel `tfsdk:"applications"`
+	ConfigHash                      types.String              `tfsdk:"config_hash"`
+	ConnectToDockerNetwork          types.Bool                `tfsdk:"connect_to_docker_network"`
+	CreatedAt                       types.String              `tfsdk:"created_at"`
+	Databases                       []serviceDatabaseModel    `tfsdk:"databases"`
+	DeletedAt                       types.String              `tfsdk:"deleted_at"`
+	Description                     types.String              `tfsdk:"description"`
+	DestinationId                   types.Int64               `tfsdk:"destination_id"`
+	DestinationType                 types.String              `tfsdk:"destination_type"`
+	DockerCompose                   types.String              `tfsdk:"docker_compose"`
+	DockerComposeRaw                types.String              `tfsdk:"docker_compose_raw"`
+	EnvironmentId                   types.Int64               `tfsdk:"environment_id"`
+	Id                              types.Int64               `tfsdk:"id"`
+	IsContainerLabelEscapeEnabled   types.Bool                `tfsdk:"is_container_label_escape_enabled"`
+	IsContainerLabelReadonlyEnabled types.Bool                `tfsdk:"is_container_label_readonly_enabled"`
+	Name                            types.String              `tfsdk:"name"`
+	ServerId                        types.Int64               `tfsdk:"server_id"`
+	ServiceType                     types.String              `tfsdk:"service_type"`
+	UpdatedAt                       types.String              `tfsdk:"updated_at"`
+	Uuid                            types.String              `tfsdk:"uuid"`
+}
+
+// serviceApplicationModel is an application that is part of a service, ie the web container of Plausible.
+type serviceApplicationModel struct {
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Description types.String `tfsdk:"description"`
+	Fqdn        types.String `tfsdk:"fqdn"`
+	HumanName   types.String `tfsdk:"human_name"`
+	Image       types.String `tfsdk:"image"`
+	Name        types.String `tfsdk:"name"`
+	Status      types.String `tfsdk:"status"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	Uuid        types.String `tfsdk:"uuid"`
+}
+
+// serviceDatabaseModel is a database that is part of a service, ie the PostgreSQL container of Plausible.
+type serviceDatabaseModel struct {
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Description types.String `tfsdk:"description"`
+	HumanName   types.String `tfsdk:"human_name"`
+	Image       types.String `tfsdk:"image"`
+	IsPublic    types.Bool   `tfsdk:"is_public"`
+	Name        types.String `tfsdk:"name"`
+	PublicPort  types.Int64  `tfsdk:"public_port"`
+	Status      types.String `tfsdk:"status"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	Uuid        types.String `tfsdk:"uuid"`
+}
+
+var _ filter.FilterableStructModel = serviceModel{}
+
+var servicesMostRecentField = "created_at"
+
+func (m serviceModel) FilterAttributes() map[string]attr.Value {
+	return filter.StructAttributes(m)
+}
+
+func (m serviceModel) FromAPI(apiModel *api.Service) serviceModel {
+	return serviceModel{
+		ConfigHash:                      flatten.String(apiModel.ConfigHash),
+		ConnectToDockerNetwork:          flatten.Bool(apiModel.ConnectToDockerNetwork),
+		CreatedAt:                       flatten.String(apiModel.CreatedAt),
+		DeletedAt:                       flatten.String(apiModel.DeletedAt),
+		Description:                     flatten.String(apiModel.Description),
+		DestinationId:                   flatten.Int64(apiModel.DestinationId),
+		DestinationType:                 flatten.String(apiModel.DestinationType),
+		DockerCompose:                   flatten.String(apiModel.DockerCompose),
+		DockerComposeRaw:                flatten.String(apiModel.DockerComposeRaw),
+		EnvironmentId:                   flatten.Int64(apiModel.EnvironmentId),
+		Id:                              flatten.Int64(apiModel.Id),
+		IsContainerLabelEscapeEnabled:   flatten.Bool(apiModel.IsContainerLabelEscapeEnabled),
+		IsContainerLabelReadonlyEnabled: flatten.Bool(apiModel.IsContainerLabelReadonlyEnabled),
+		Name:                            flatten.String(apiModel.Name),
+		ServerId:                        flatten.Int64(apiModel.ServerId),
+		ServiceType:                     flatten.String(apiModel.ServiceType),
+		UpdatedAt:                       flatten.String(apiModel.UpdatedAt),
+		Uuid:                            flatten.String(apiModel.Uuid),
+	}
+}
+
+// serviceResourcesFromBody extracts the applications and databases of a service from a raw service response.
+// They are not part of the documented API model, so anything unexpected is ignored.
+func serviceResourcesFromBody(body []byte) ([]serviceApplicationModel, []serviceDatabaseModel) {
+	var service struct {
+		Applications []struct {
+			CreatedAt   *string `json:"created_at"`
+			Description *string `json:"description"`
+			Fqdn        *string `json:"fqdn"`
+			HumanName   *string `json:"human_name"`
+			Image       *string `json:"image"`
+			Name        *string `json:"name"`
+			Status      *string `json:"status"`
+			UpdatedAt   *string `json:"updated_at"`
+			Uuid        *string `json:"uuid"`
+		} `json:"applications"`
+		Databases []struct {
+			CreatedAt   *string `json:"created_at"`
+			Description *string `json:"description"`
+			HumanName   *string `json:"human_name"`
+			Image       *string `json:"image"`
+			IsPublic    *bool   `json:"is_public"`
+			Name        *string `json:"name"`
+			PublicPort  *int    `json:"public_port"`
+			Status      *string `json:"status"`
+			UpdatedAt   *string `json:"updated_at"`
+			Uuid        *string `json:"uuid"`
+		} `json:"databases"`
+	}
+	if err := json.Unmarshal(body, &service); err != nil {
+		return []serviceApplicationModel{}, []serviceDatabaseModel{}
+	}
+
+	applications := make([]serviceApplicationModel, 0, len(service.Applications))
+	for _, app := range service.Applications {
+		applications = append(applications, serviceApplicationModel{
+			CreatedAt:   flatten.String(app.CreatedAt),
+			Description: flatten.String(app.Description),
+			Fqdn:        flatten.String(app.Fqdn),
+			HumanName:   flatten.String(app.HumanName),
+			Image:       flatten.String(app.Image),
+			Name:        flatten.String(app.Name),
+			Status:      flatten.String(app.Status),
+			UpdatedAt:   flatten.String(app.UpdatedAt),
+			Uuid:        flatten.String(app.Uuid),
+		})
+	}
+
+	databases := make([]serviceDatabaseModel, 0, len(service.Databases))
+	for _, db := range service.Databases {
+		databases = append(databases, serviceDatabaseModel{
+			CreatedAt:   flatten.String(db.CreatedAt),
+			Description: flatten.String(db.Description),
+			HumanName:   flatten.String(db.HumanName),
+			Image:       flatten.String(db.Image),
+			IsPublic:    flatten.Bool(db.IsPublic),
+			Name:        flatten.String(db.Name),
+			PublicPort:  flatten.Int64(db.PublicPort),
+			Status:      flatten.String(db.Status),
+			UpdatedAt:   flatten.String(db.UpdatedAt),
+			Uuid:        flatten.String(db.Uuid),
+		})
+	}
+
+	return applications, databases
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/testutils"
+)
+
+func TestServiceModel_Attributes(t *testing.T) {
+	model := serviceModel{}
+
+	expected := testutils.GenerateAttrTypesFromStruct(t, model)
+	actual := model.FilterAttributes()
+
+	// Every filter name derived from the list schema must be filterable
+	resp := &datasource.SchemaResponse{}
+	NewServicesDataSource().Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	filterNames := filter.NamesFromSchema(resp.Schema.Attributes["services"].(schema.ListNestedAttribute).NestedObject.Attributes)
+
+	for _, name := range []string{"service_type", "name", "server_id", "environment_id"} {
+		assert.Contains(t, filterNames, name)
+	}
+
+	for _, key := range filterNames {
+		_, exists := actual[key]
+		assert.True(t, exists, "Key %q should exist in actual attributes", key)
+	}
+
+	for key := range actual {
+		_, exists := expected[key]
+		assert.True(t, exists, "Key %q should exist in expected attributes", key)
+	}
+}
+
+func TestServiceResourcesFromBody(t *testing.T) {
+	applications, databases := serviceResourcesFromBody([]byte(`{
+		"uuid": "svc",
+		"applications": [{"uuid": "app1", "name": "plausible", "human_name": "Plausible", "fqdn": "https://plausible.example.com", "status": "running:healthy"}],
+		"databases": [{"uuid": "db1", "name": "plausible-db", "is_public": false, "public_port": null}]
+	}`))
+
+	if assert.Len(t, applications, 1) {
+		assert.Equal(t, types.StringValue("app1"), applications[0].Uuid)
+		assert.Equal(t, types.StringValue("https://plausible.example.com"), applications[0].Fqdn)
+		assert.Equal(t, types.StringNull(), applications[0].Image)
+	}
+	if assert.Len(t, databases, 1) {
+		assert.Equal(t, types.StringValue("plausible-db"), databases[0].Name)
+		assert.Equal(t, types.BoolValue(false), databases[0].IsPublic)
+		assert.Equal(t, types.Int64Null(), databases[0].PublicPort)
+	}
+
+	applications, databases = serviceResourcesFromBody([]byte(`not json`))
+	assert.Empty(t, applications)
+	assert.Empty(t, databases)
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+	"terraform-provider-coolify/internal/provider/generated/datasource_services"
+	"terraform-provider-coolify/internal/provider/util"
+)
+
+var _ datasource.DataSource = &servicesDataSource{}
+var _ datasource.DataSourceWithConfigure = &servicesDataSource{}
+
+type servicesDataSourceModel struct {
+	Services      []serviceModel      `tfsdk:"services"`
+	WithResources types.Bool          `tfsdk:"with_resources"`
+	Filter        []filter.BlockModel `tfsdk:"filter"`
+	filter.SortModel
+}
+
+func NewServicesDataSource() datasource.DataSource {
+	return &servicesDataSource{}
+}
+
+type servicesDataSource struct {
+	client *api.ClientWithResponses
+}
+
+func (d *servicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_services.ServicesDataSourceSchema(ctx)
+	resp.Schema.Description = "Get a list of Coolify services."
+
+	if servicesSet, ok := resp.Schema.Attributes["services"].(schema.SetNestedAttribute); ok {
+		servicesSet.NestedObject.Attributes["applications"] = schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The applications of the service. Only set when `with_resources` is enabled.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"created_at":  schema.StringAttribute{Computed: true, Description: "The date and time the application was created."},
+					"description": schema.StringAttribute{Computed: true, Description: "The description of the application."},
+					"fqdn":        schema.StringAttribute{Computed: true, Description: "The domains of the application."},
+					"human_name":  schema.StringAttribute{Computed: true, Description: "The display name of the application."},
+					"image":       schema.StringAttribute{Computed: true, Description: "The Docker image of the application."},
+					"name":        schema.StringAttribute{Computed: true, Description: "The name of the application in the compose file."},
+					"status":      schema.StringAttribute{Computed: true, Description: "The status of the application."},
+					"updated_at":  schema.StringAttribute{Computed: true, Description: "The date and time the application was last updated."},
+					"uuid":        schema.StringAttribute{Computed: true, Description: "The UUID of the application."},
+				},
+			},
+		}
+		servicesSet.NestedObject.Attributes["databases"] = schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The databases of the service. Only set when `with_resources` is enabled.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"created_at":  schema.StringAttribute{Computed: true, Description: "The date and time the database was created."},
+					"description": schema.StringAttribute{Computed: true, Description: "The description of the database."},
+					"human_name":  schema.StringAttribute{Computed: true, Description: "The display name of the database."},
+					"image":       schema.StringAttribute{Computed: true, Description: "The Docker image of the database."},
+					"is_public":   schema.BoolAttribute{Computed: true, Description: "Whether the database is publicly accessible."},
+					"name":        schema.StringAttribute{Computed: true, Description: "The name of the database in the compose file."},
+					"public_port": schema.Int64Attribute{Computed: true, Description: "The public port of the database."},
+					"status":      schema.StringAttribute{Computed: true, Description: "The status of the database."},
+					"updated_at":  schema.StringAttribute{Computed: true, Description: "The date and time the database was last updated."},
+					"uuid":        schema.StringAttribute{Computed: true, Description: "The UUID of the database."},
+				},
+			},
+		}
+		resp.Schema.Attributes["services"] = servicesSet
+	}
+
+	resp.Schema.Attributes["with_resources"] = schema.BoolAttribute{
+		Description: "Whether to fetch the applications and databases of each service. This requires an additional API call per service.",
+		Optional:    true,
+	}
+
+	makeDataSourceListFilterable(&resp.Schema, "services", servicesMostRecentField)
+}
+
+func (d *servicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	util.ProviderDataFromDataSourceConfigureRequest(req, &d.client, resp)
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan servicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResponse, err := d.client.ListServicesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading services", err.Error(),
+		)
+		return
+	}
+
+	if listResponse.StatusCode() != http.StatusOK || listResponse.JSON200 == nil {
+		util.AddAPIError(&resp.Diagnostics, "reading services", listResponse.HTTPResponse, listResponse.Body, nil)
+		return
+	}
+
+	state, diag := d.apiToModel(ctx, listResponse.JSON200, plan.Filter, plan.SortModel, plan.WithResources)
+	resp.Diagnostics.Append(diag...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *servicesDataSource) apiToModel(
+	ctx context.Context,
+	services *[]api.Service,
+	filters []filter.BlockModel,
+	sort filter.SortModel,
+	withResources types.Bool,
+) (servicesDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var serviceValues []serviceModel
+
+	matcher := filter.Compile(ctx, filters)
+	for _, service := range *services {
+		model := serviceModel{}.FromAPI(&service)
+
+		if !matcher.OnStruct(model) {
+			continue
+		}
+
+		serviceValues = append(serviceValues, model)
+	}
+
+	serviceValues = filter.Sort(serviceValues, serviceModel.FilterAttributes, sort, servicesMostRecentField)
+
+	// Fetch resources once filtered and limited, to only fetch them for the services returned
+	if withResources.ValueBool() {
+		forEachConcurrently(&diags, len(serviceValues), inventoryConcurrency, func(i int, diags *diag.Diagnostics) {
+			uuid := serviceValues[i].Uuid.ValueString()
+			if uuid == "" {
+				return
+			}
+
+			serviceResponse, err := d.client.GetServiceByUuidWithResponse(ctx, uuid)
+			if err != nil {
+				diags.AddError(
+					"Error reading service resources",
+					err.Error(),
+				)
+				return
+			}
+
+			if serviceResponse.StatusCode() != http.StatusOK {
+				util.AddAPIError(diags, "reading service resources", serviceResponse.HTTPResponse, serviceResponse.Body, nil)
+				return
+			}
+
+			serviceValues[i].Applications, serviceValues[i].Databases = serviceResourcesFromBody(serviceResponse.Body)
+		})
+	}
+
+	return servicesDataSourceModel{
+		Services:      serviceValues,
+		WithResources: withResources,
+		Filter:        filters,
+		SortModel:     sort,
+	}, diags
+}
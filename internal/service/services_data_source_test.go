@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-coolify/internal/acctest"
+	"terraform-provider-coolify/internal/service"
+)
+
+func TestAccServicesDataSource(t *testing.T) {
+	resName := "data.coolify_services.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{ // Without resources
+				Config: `
+				data "coolify_services" "test" {
+					filter {
+						name   = "uuid"
+						values = ["` + acctest.ServiceUUID + `"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "services.#", "1"),
+					resource.TestCheckResourceAttr(resName, "services.0.uuid", acctest.ServiceUUID),
+					resource.TestCheckResourceAttr(resName, "services.0.name", "service-"+acctest.ServiceUUID),
+					resource.TestCheckNoResourceAttr(resName, "services.0.applications.#"),
+				),
+			},
+			{ // With resources
+				Config: `
+				data "coolify_services" "test" {
+					with_resources = true
+					filter {
+						name   = "name"
+						values = ["service-` + acctest.ServiceUUID + `"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "services.#", "1"),
+					resource.TestCheckResourceAttrSet(resName, "services.0.applications.#"),
+					resource.TestCheckResourceAttrSet(resName, "services.0.databases.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestServicesDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := service.NewServicesDataSource()
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.Contains(t, resp.Schema.Attributes, "with_resources")
+	assert.Contains(t, resp.Schema.Blocks, "filter")
+
+	nested := resp.Schema.Attributes["services"].(schema.ListNestedAttribute).NestedObject.Attributes
+	assert.Contains(t, nested, "applications")
+	assert.Contains(t, nested, "databases")
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-coolify/internal/api"
+	"terraform-provider-coolify/internal/filter"
+)
+
+func TestServicesWithResources(t *testing.T) {
+	var mu sync.Mutex
+	var fetched []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fetched = append(fetched, r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"applications": [{"uuid": "app", "name": "web"}], "databases": []}`)
+	}))
+	defer server.Close()
+	client, err := api.NewAPIClient("test", server.URL, "1|test", api.RetryConfig{}, api.LimitConfig{})
+	require.NoError(t, err)
+	d := &servicesDataSource{client: client}
+
+	services := []api.Service{}
+	for _, name := range []string{"a", "c", "b"} {
+		uuid := "uuid-" + name
+		services = append(services, api.Service{Uuid: &uuid, Name: &name})
+	}
+	sort := filter.SortModel{
+		SortBy: types.StringValue("name"),
+		Order:  types.StringValue(filter.OrderDesc),
+		Limit:  types.Int64Value(2),
+	}
+
+	state, diags := d.apiToModel(context.Background(), &services, nil, sort, types.BoolValue(true))
+
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, state.Services, 2)
+	assert.Equal(t, "c", state.Services[0].Name.ValueString())
+	assert.Equal(t, "b", state.Services[1].Name.ValueString())
+	assert.Len(t, state.Services[0].Applications, 1)
+	assert.Len(t, state.Services[1].Applications, 1)
+	assert.ElementsMatch(t, []string{"/services/uuid-c", "/services/uuid-b"}, fetched, "only the returned services are fetched")
+}
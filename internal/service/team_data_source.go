@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -144,10 +143,7 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 
 		if teamResp.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError(
-				"Unexpected HTTP status code reading team",
-				fmt.Sprintf("Received %s for team. Details: %s", teamResp.Status(), string(teamResp.Body)),
-			)
+			util.AddAPIError(&resp.Diagnostics, "reading team", teamResp.HTTPResponse, teamResp.Body, nil)
 			return
 		}
 
@@ -164,10 +160,7 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 
 		if teamResp.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError(
-				"Unexpected HTTP status code reading team",
-				fmt.Sprintf("Received %s for team. Details: %s", teamResp.Status(), string(teamResp.Body)),
-			)
+			util.AddAPIError(&resp.Diagnostics, "reading team", teamResp.HTTPResponse, teamResp.Body, nil)
 			return
 		}
 
@@ -187,10 +180,7 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 
 		if teamMembersResponse.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError(
-				"Unexpected HTTP status code reading team members",
-				fmt.Sprintf("Received %s for team members. Details: %s", teamMembersResponse.Status(), string(teamMembersResponse.Body)),
-			)
+			util.AddAPIError(&resp.Diagnostics, "reading team members", teamMembersResponse.HTTPResponse, teamMembersResponse.Body, nil)
 			return
 		}
 		team.Members = teamMembersResponse.JSON200
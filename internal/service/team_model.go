@@ -68,13 +68,8 @@ func (m teamModel) FromAPI(apiModel *api.Team) teamModel {
 	}
 }
 
-var teamsFilterNames = []string{"name", "description", "id", "personal_team"}
+var teamsMostRecentField = "created_at"
 
 func (m teamModel) FilterAttributes() map[string]attr.Value {
-	return map[string]attr.Value{
-		"description":   m.Description,
-		"id":            m.Id,
-		"name":          m.Name,
-		"personal_team": m.PersonalTeam,
-	}
+	return filter.StructAttributes(m)
 }
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -22,6 +21,7 @@ type teamsDataSourceModel struct {
 	Teams       []teamDataSourceModel `tfsdk:"teams"`
 	WithMembers types.Bool            `tfsdk:"with_members"`
 	Filter      []filter.BlockModel   `tfsdk:"filter"`
+	filter.SortModel
 }
 
 func NewTeamsDataSource() datasource.DataSource {
@@ -51,7 +51,7 @@ func (d *teamsDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "Get a list of Coolify teams.",
 		Attributes: map[string]schema.Attribute{
-			"teams": schema.SetNestedAttribute{
+			"teams": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: dsResp.Schema.Attributes,
@@ -62,10 +62,9 @@ func (d *teamsDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Optional:    true,
 			},
 		},
-		Blocks: map[string]schema.Block{
-			"filter": filter.CreateDatasourceFilter(teamsFilterNames),
-		},
 	}
+
+	makeDataSourceListFilterable(&resp.Schema, "teams", teamsMostRecentField)
 }
 
 func (d *teamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -91,14 +90,11 @@ func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	if listResponse.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading teams",
-			fmt.Sprintf("Received %s for teams. Details: %s", listResponse.Status(), listResponse.Body),
-		)
+		util.AddAPIError(&resp.Diagnostics, "reading teams", listResponse.HTTPResponse, listResponse.Body, nil)
 		return
 	}
 
-	state, diag := d.apiToModel(ctx, listResponse.JSON200, plan.Filter, plan.WithMembers)
+	state, diag := d.apiToModel(ctx, listResponse.JSON200, plan.Filter, plan.SortModel, plan.WithMembers)
 	resp.Diagnostics.Append(diag...)
 
 	if resp.Diagnostics.HasError() {
@@ -112,12 +108,14 @@ func (d *teamsDataSource) apiToModel(
 	ctx context.Context,
 	teams *[]api.Team,
 	filters []filter.BlockModel,
+	sort filter.SortModel,
 	withMembers types.Bool,
 ) (teamsDataSourceModel, diag.Diagnostics) {
 
 	var diags diag.Diagnostics
 	var teamValues []teamDataSourceModel
 
+	matcher := filter.Compile(ctx, filters)
 	for _, team := range *teams {
 		if team.Members == nil && withMembers.ValueBool() {
 			// Fetch members separately if requested and not included in team response
@@ -131,10 +129,7 @@ func (d *teamsDataSource) apiToModel(
 			}
 
 			if teamMembersResponse.StatusCode() != http.StatusOK {
-				diags.AddError(
-					"Unexpected HTTP status code reading team members",
-					fmt.Sprintf("Received %s for team members. Details: %s", teamMembersResponse.Status(), string(teamMembersResponse.Body)),
-				)
+				util.AddAPIError(&diags, "reading team members", teamMembersResponse.HTTPResponse, teamMembersResponse.Body, nil)
 				continue
 			}
 
@@ -145,16 +140,19 @@ func (d *teamsDataSource) apiToModel(
 
 		model := teamDataSourceModel{}.FromAPI(&team)
 
-		if !filter.OnStruct(ctx, model, filters) {
+		if !matcher.OnStruct(model) {
 			continue
 		}
 
 		teamValues = append(teamValues, model)
 	}
 
+	teamValues = filter.Sort(teamValues, teamDataSourceModel.FilterAttributes, sort, teamsMostRecentField)
+
 	return teamsDataSourceModel{
 		Teams:       teamValues,
 		WithMembers: withMembers,
 		Filter:      filters,
+		SortModel:   sort,
 	}, diags
 }
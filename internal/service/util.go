@@ -2,6 +2,8 @@ package service
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"strings"
 
 	ds_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	res_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -90,3 +92,30 @@ func mergeDataSourceSchemas(schemas ...ds_schema.Schema) ds_schema.Schema {
 
 	return result
 }
+
+// serverProxyFromBody extracts the proxy type and status from a raw server response.
+// Coolify does not always return `proxy` as an object, so anything unexpected is treated as missing.
+func serverProxyFromBody(body []byte) (proxyType types.String, proxyStatus types.String) {
+	proxyType, proxyStatus = types.StringNull(), types.StringNull()
+
+	var server struct {
+		Proxy json.RawMessage `json:"proxy"`
+	}
+	if err := json.Unmarshal(body, &server); err != nil || len(server.Proxy) == 0 {
+		return
+	}
+
+	var proxy map[string]interface{}
+	if err := json.Unmarshal(server.Proxy, &proxy); err != nil {
+		return
+	}
+
+	// Coolify stores the type in upper case, ie `TRAEFIK`
+	if value, ok := proxy["type"].(string); ok && value != "" {
+		proxyType = types.StringValue(strings.ToLower(value))
+	}
+	if value, ok := proxy["status"].(string); ok && value != "" {
+		proxyStatus = types.StringValue(value)
+	}
+	return
+}
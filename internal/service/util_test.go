@@ -209,3 +209,29 @@ func TestCombineDataSourceSchemas(t *testing.T) {
 		})
 	}
 }
+
+func TestServerProxyFromBody(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		expectedType   types.String
+		expectedStatus types.String
+	}{
+		{"proxy object", `{"proxy":{"type":"TRAEFIK","status":"running"}}`, types.StringValue("traefik"), types.StringValue("running")},
+		{"missing status", `{"proxy":{"type":"CADDY"}}`, types.StringValue("caddy"), types.StringNull()},
+		{"missing proxy", `{"uuid":"abc"}`, types.StringNull(), types.StringNull()},
+		{"null proxy", `{"proxy":null}`, types.StringNull(), types.StringNull()},
+		{"empty array proxy", `{"proxy":[]}`, types.StringNull(), types.StringNull()},
+		{"string proxy", `{"proxy":"traefik"}`, types.StringNull(), types.StringNull()},
+		{"unexpected field types", `{"proxy":{"type":1,"status":false}}`, types.StringNull(), types.StringNull()},
+		{"invalid body", `not json`, types.StringNull(), types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxyType, proxyStatus := serverProxyFromBody([]byte(tt.body))
+			assert.Equal(t, tt.expectedType, proxyType)
+			assert.Equal(t, tt.expectedStatus, proxyStatus)
+		})
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"terraform-provider-coolify/internal/generate"
 	"terraform-provider-coolify/internal/provider"
 )
 
@@ -20,6 +22,14 @@ var (
 )
 
 func main() {
+	// `generate` writes import blocks and configuration for existing infrastructure, see `generate -help`
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate.Run(context.Background(), version, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")